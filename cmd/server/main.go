@@ -3,9 +3,13 @@ package main
 import (
 	"log"
 	"os"
+	"strconv"
+	"time"
+	_ "time/tzdata" // interview timezones must resolve even on images without zoneinfo
 
 	"github.com/dekkaladiwakar/black-pages-backend/internal/handlers"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
@@ -30,8 +34,9 @@ func main() {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
-	// Initialize Gin router
-	router := gin.Default()
+	// Initialize Gin router; the logger redacts tokens passed in query strings
+	router := gin.New()
+	router.Use(middleware.RequestLogger(), gin.Recovery())
 
 	// Setup CORS
 	config := cors.DefaultConfig()
@@ -73,18 +78,77 @@ func main() {
 	jobRepo := repositories.NewJobRepository(utils.GetDB())
 	applicationRepo := repositories.NewApplicationRepository(utils.GetDB())
 	studentProfileRepo := repositories.NewStudentProfileRepository(utils.GetDB())
+	professionalProfileRepo := repositories.NewProfessionalProfileRepository(utils.GetDB())
+	freelancerProfileRepo := repositories.NewFreelancerProfileRepository(utils.GetDB())
 	firmProfileRepo := repositories.NewFirmProfileRepository(utils.GetDB())
+	jobRevisionRepo := repositories.NewJobRevisionRepository(utils.GetDB())
+	pipelineStageRepo := repositories.NewPipelineStageRepository(utils.GetDB())
+	applicationReviewRepo := repositories.NewApplicationReviewRepository(utils.GetDB())
+	conversationRepo := repositories.NewConversationRepository(utils.GetDB())
+	notificationRepo := repositories.NewNotificationRepository(utils.GetDB())
+	emailOutboxRepo := repositories.NewEmailOutboxRepository(utils.GetDB())
+	interviewRepo := repositories.NewInterviewRepository(utils.GetDB())
+	offerRepo := repositories.NewOfferRepository(utils.GetDB())
+	invitationRepo := repositories.NewInvitationRepository(utils.GetDB())
+	portfolioRepo := repositories.NewPortfolioRepository(utils.GetDB())
 	
-	authService := services.NewAuthService(userRepo)
+	notificationService := services.NewNotificationService(notificationRepo, services.NewNotificationHub())
+
+	// Emails go through SMTP when configured, otherwise they are only logged
+	var mailer services.Mailer
+	if smtpHost := os.Getenv("SMTP_HOST"); smtpHost != "" {
+		smtpPort := os.Getenv("SMTP_PORT")
+		if smtpPort == "" {
+			smtpPort = "587"
+		}
+		emailFrom := os.Getenv("EMAIL_FROM")
+		if emailFrom == "" {
+			emailFrom = "no-reply@blackpages.local"
+		}
+		mailer = services.NewSMTPMailer(smtpHost, smtpPort, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), emailFrom)
+	} else {
+		mailer = services.NewLogMailer()
+	}
+	frontendURL := os.Getenv("FRONTEND_URL")
+	if frontendURL == "" {
+		frontendURL = "http://localhost:3000"
+	}
+	emailService := services.NewEmailService(emailOutboxRepo, userRepo, mailer, frontendURL)
+	emailService.Start(30 * time.Second)
+
+	authService := services.NewAuthService(userRepo, emailService)
 	jobSeekerService := services.NewJobSeekerService(jobSeekerRepo, userRepo)
 	employerService := services.NewEmployerService(employerRepo, userRepo)
-	jobService := services.NewJobService(jobRepo, employerRepo)
-	applicationService := services.NewApplicationService(applicationRepo, jobRepo, jobSeekerRepo, employerRepo)
+	jobService := services.NewJobService(jobRepo, employerRepo, jobRevisionRepo, pipelineStageRepo, offerRepo)
+	applicationService := services.NewApplicationService(applicationRepo, jobRepo, jobSeekerRepo, employerRepo, jobRevisionRepo, pipelineStageRepo, portfolioRepo, interviewRepo, notificationService, emailService)
+	applicationReviewService := services.NewApplicationReviewService(applicationReviewRepo, applicationRepo)
 	studentProfileService := services.NewStudentProfileService(studentProfileRepo, jobSeekerRepo)
+	professionalProfileService := services.NewProfessionalProfileService(professionalProfileRepo, jobSeekerRepo)
+	freelancerProfileService := services.NewFreelancerProfileService(freelancerProfileRepo, jobSeekerRepo)
 	firmProfileService := services.NewFirmProfileService(firmProfileRepo, employerRepo)
 	
+	retentionDays, err := strconv.Atoi(os.Getenv("SOFT_DELETE_RETENTION_DAYS"))
+	if err != nil || retentionDays <= 0 {
+		retentionDays = 30
+	}
+	retentionService := services.NewRetentionService(retentionDays, jobRepo, applicationRepo, studentProfileRepo, professionalProfileRepo, freelancerProfileRepo, firmProfileRepo)
+	retentionService.Start(24 * time.Hour)
+
+	jobDeadlineService := services.NewJobDeadlineService(48*time.Hour, jobRepo, notificationService, emailService)
+	jobDeadlineService.Start(time.Hour)
+
 	storageService := services.NewMockS3Service()
 	fileService := services.NewFileService(storageService)
+	interviewService := services.NewInterviewService(interviewRepo, applicationRepo, userRepo, notificationService, 24*time.Hour)
+	interviewService.Start(15 * time.Minute)
+	offerService := services.NewOfferService(offerRepo, applicationRepo, jobRepo, fileService, notificationService)
+	companyService := services.NewCompanyService(employerRepo, jobRepo)
+	talentService := services.NewTalentService(jobSeekerRepo)
+	invitationService := services.NewInvitationService(invitationRepo, jobRepo, jobSeekerRepo, applicationRepo, applicationService, notificationService)
+	portfolioService := services.NewPortfolioService(portfolioRepo, jobSeekerRepo, fileService)
+	publicProfileService := services.NewPublicProfileService(jobSeekerRepo)
+	avatarService := services.NewAvatarService(userRepo, jobSeekerRepo, employerRepo, fileService)
+	conversationService := services.NewConversationService(conversationRepo, applicationRepo, fileService, notificationService)
 	
 	authHandler := handlers.NewAuthHandler(authService)
 	jobSeekerHandler := handlers.NewJobSeekerHandler(jobSeekerService)
@@ -92,7 +156,18 @@ func main() {
 	uploadHandler := handlers.NewUploadHandler(fileService, jobSeekerService)
 	jobHandler := handlers.NewJobHandler(jobService, employerService)
 	applicationHandler := handlers.NewApplicationHandler(applicationService, jobSeekerService, employerService)
-	profileExtensionHandler := handlers.NewProfileExtensionHandler(studentProfileService, firmProfileService, jobSeekerService, employerService)
+	applicationReviewHandler := handlers.NewApplicationReviewHandler(applicationReviewService, employerService)
+	profileExtensionHandler := handlers.NewProfileExtensionHandler(studentProfileService, professionalProfileService, freelancerProfileService, firmProfileService, jobSeekerService, employerService)
+	conversationHandler := handlers.NewConversationHandler(conversationService)
+	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	interviewHandler := handlers.NewInterviewHandler(interviewService)
+	offerHandler := handlers.NewOfferHandler(offerService, jobSeekerService, employerService)
+	companyHandler := handlers.NewCompanyHandler(companyService)
+	talentHandler := handlers.NewTalentHandler(talentService, employerService)
+	invitationHandler := handlers.NewInvitationHandler(invitationService, jobSeekerService, employerService)
+	portfolioHandler := handlers.NewPortfolioHandler(portfolioService, jobSeekerService)
+	publicProfileHandler := handlers.NewPublicProfileHandler(publicProfileService, jobSeekerService)
+	avatarHandler := handlers.NewAvatarHandler(avatarService)
 
 	// API routes group
 	api := router.Group("/api")
@@ -112,6 +187,14 @@ func main() {
 			auth.GET("/me", middleware.AuthRequired(), authHandler.GetMe)
 		}
 
+		// Profile photos; avatars are public so they can be used in image tags
+		users := api.Group("/users")
+		{
+			users.POST("/me/avatar", middleware.AuthRequired(), avatarHandler.UploadAvatar)
+			users.DELETE("/me/avatar", middleware.AuthRequired(), avatarHandler.RemoveAvatar)
+			users.GET("/:id/avatar", avatarHandler.GetAvatar)
+		}
+
 		// Job Seeker routes
 		jobSeekers := api.Group("/job-seekers")
 		jobSeekers.Use(middleware.AuthRequired())
@@ -127,6 +210,45 @@ func main() {
 			jobSeekers.GET("/student-profile", profileExtensionHandler.GetStudentProfile)
 			jobSeekers.PUT("/student-profile", profileExtensionHandler.UpdateStudentProfile)
 			jobSeekers.DELETE("/student-profile", profileExtensionHandler.DeleteStudentProfile)
+			jobSeekers.POST("/student-profile/restore", profileExtensionHandler.RestoreStudentProfile)
+			jobSeekers.GET("/student-profile/internships", profileExtensionHandler.GetStudentEntries(models.EntryTypeInternship))
+			jobSeekers.POST("/student-profile/internships", profileExtensionHandler.AddStudentEntry(models.EntryTypeInternship))
+			jobSeekers.PUT("/student-profile/internships/:id", profileExtensionHandler.UpdateStudentEntry(models.EntryTypeInternship))
+			jobSeekers.DELETE("/student-profile/internships/:id", profileExtensionHandler.DeleteStudentEntry(models.EntryTypeInternship))
+			jobSeekers.GET("/student-profile/freelance-projects", profileExtensionHandler.GetStudentEntries(models.EntryTypeFreelanceProject))
+			jobSeekers.POST("/student-profile/freelance-projects", profileExtensionHandler.AddStudentEntry(models.EntryTypeFreelanceProject))
+			jobSeekers.PUT("/student-profile/freelance-projects/:id", profileExtensionHandler.UpdateStudentEntry(models.EntryTypeFreelanceProject))
+			jobSeekers.DELETE("/student-profile/freelance-projects/:id", profileExtensionHandler.DeleteStudentEntry(models.EntryTypeFreelanceProject))
+
+			// Professional profile extension routes
+			jobSeekers.POST("/professional-profile", profileExtensionHandler.CreateProfessionalProfile)
+			jobSeekers.GET("/professional-profile", profileExtensionHandler.GetProfessionalProfile)
+			jobSeekers.PUT("/professional-profile", profileExtensionHandler.UpdateProfessionalProfile)
+			jobSeekers.DELETE("/professional-profile", profileExtensionHandler.DeleteProfessionalProfile)
+			jobSeekers.POST("/professional-profile/restore", profileExtensionHandler.RestoreProfessionalProfile)
+
+			// Freelancer profile extension routes
+			jobSeekers.POST("/freelancer-profile", profileExtensionHandler.CreateFreelancerProfile)
+			jobSeekers.GET("/freelancer-profile", profileExtensionHandler.GetFreelancerProfile)
+			jobSeekers.PUT("/freelancer-profile", profileExtensionHandler.UpdateFreelancerProfile)
+			jobSeekers.DELETE("/freelancer-profile", profileExtensionHandler.DeleteFreelancerProfile)
+			jobSeekers.POST("/freelancer-profile/restore", profileExtensionHandler.RestoreFreelancerProfile)
+
+			// Portfolio projects
+			jobSeekers.GET("/portfolio/projects", portfolioHandler.GetProjects)
+			jobSeekers.POST("/portfolio/projects", portfolioHandler.CreateProject)
+			jobSeekers.PUT("/portfolio/projects/:id", portfolioHandler.UpdateProject)
+			jobSeekers.DELETE("/portfolio/projects/:id", portfolioHandler.DeleteProject)
+			jobSeekers.PUT("/portfolio/order", portfolioHandler.ReorderProjects)
+			jobSeekers.POST("/portfolio/projects/:id/media", portfolioHandler.UploadMedia)
+			jobSeekers.PUT("/portfolio/projects/:id/media/order", portfolioHandler.ReorderMedia)
+			jobSeekers.DELETE("/portfolio/projects/:id/media/:mediaId", portfolioHandler.DeleteMedia)
+			jobSeekers.POST("/portfolio/share", portfolioHandler.CreateShareLink)
+			jobSeekers.DELETE("/portfolio/share", portfolioHandler.DisableSharing)
+
+			// Public profile link and privacy settings
+			jobSeekers.GET("/public-profile", publicProfileHandler.GetSettings)
+			jobSeekers.PUT("/public-profile", publicProfileHandler.UpdateSettings)
 		}
 
 		// Employer routes
@@ -144,6 +266,32 @@ func main() {
 			employers.GET("/firm-profile", profileExtensionHandler.GetFirmProfile)
 			employers.PUT("/firm-profile", profileExtensionHandler.UpdateFirmProfile)
 			employers.DELETE("/firm-profile", profileExtensionHandler.DeleteFirmProfile)
+			employers.POST("/firm-profile/restore", profileExtensionHandler.RestoreFirmProfile)
+
+			// Bulk application actions
+			employers.POST("/applications/bulk-status", applicationHandler.BulkUpdateApplicationStatus)
+
+			// Private hiring team notes, ratings and tags
+			employers.GET("/applications/:id/review", applicationReviewHandler.GetApplicationReview)
+			employers.POST("/applications/:id/notes", applicationReviewHandler.AddApplicationNote)
+			employers.PUT("/applications/:id/notes/:noteId", applicationReviewHandler.UpdateApplicationNote)
+			employers.DELETE("/applications/:id/notes/:noteId", applicationReviewHandler.DeleteApplicationNote)
+			employers.PUT("/applications/:id/rating", applicationReviewHandler.RateApplication)
+			employers.PUT("/applications/:id/tags", applicationReviewHandler.SetApplicationTags)
+
+			// Interview scheduling
+			employers.POST("/applications/:id/interviews", interviewHandler.ProposeInterview)
+			employers.GET("/applications/:id/interviews", interviewHandler.GetApplicationInterviews)
+
+			// Offers to selected candidates
+			employers.POST("/applications/:id/offers", offerHandler.CreateOffer)
+			employers.GET("/applications/:id/offers", offerHandler.GetApplicationOffers)
+			employers.POST("/offers/:id/withdraw", offerHandler.WithdrawOffer)
+
+			// Candidates who opted in to being found
+			employers.GET("/talent", talentHandler.SearchTalent)
+			employers.POST("/invitations", invitationHandler.CreateInvitation)
+			employers.GET("/invitations", invitationHandler.GetSentInvitations)
 		}
 
 		// Upload routes (job seekers only)
@@ -159,23 +307,41 @@ func main() {
 		jobs := api.Group("/jobs")
 		{
 			jobs.GET("", jobHandler.GetAllJobs)          // Browse all jobs with filters
-			jobs.GET("/:id", jobHandler.GetJob)          // Get specific job details
+			jobs.GET("/:id", jobHandler.GetPublicJob)    // Get specific job details
+			jobs.GET("/:id/eligibility", middleware.AuthRequired(), middleware.RequireRole("job_seeker"), applicationHandler.CheckEligibility) // Can I apply?
 		}
 		
 		// Job filter options endpoint (separate to avoid route conflicts)
 		api.GET("/jobs/filters", jobHandler.GetJobFilterOptions)
 
+		// Public company pages (no auth required)
+		companies := api.Group("/companies")
+		{
+			companies.GET("", companyHandler.SearchCompanies)              // Company directory with filters
+			companies.GET("/:id", companyHandler.GetCompany)               // Company page by ID
+			companies.GET("/slug/:slug", companyHandler.GetCompanyBySlug)  // Company page by URL slug
+		}
+
 		// Employer job management routes
 		employerJobs := api.Group("/employers/jobs")
 		employerJobs.Use(middleware.AuthRequired())
 		employerJobs.Use(middleware.RequireRole("employer"))
 		{
 			employerJobs.POST("", jobHandler.CreateJob)                    // Create new job
+			employerJobs.POST("/drafts", jobHandler.SaveJobDraft)          // Save incomplete job as draft
 			employerJobs.GET("", jobHandler.GetEmployerJobs)               // Get employer's jobs
+			employerJobs.GET("/deleted", jobHandler.GetDeletedJobs)        // Get soft-deleted jobs
 			employerJobs.GET("/:id", jobHandler.GetJob)                    // Get specific job
 			employerJobs.PUT("/:id", jobHandler.UpdateJob)                 // Update job
 			employerJobs.DELETE("/:id", jobHandler.DeleteJob)              // Delete job
 			employerJobs.PUT("/:id/toggle", jobHandler.ToggleJobStatus)    // Toggle active/inactive
+			employerJobs.GET("/:id/preview", jobHandler.PreviewJob)        // Preview job and publish readiness
+			employerJobs.POST("/:id/publish", jobHandler.PublishJob)       // Publish a draft
+			employerJobs.POST("/:id/clone", jobHandler.CloneJob)           // Clone job into a new draft
+			employerJobs.GET("/:id/history", jobHandler.GetJobHistory)     // Job edit history
+			employerJobs.GET("/:id/pipeline", jobHandler.GetPipeline)      // Get hiring pipeline stages
+			employerJobs.PUT("/:id/pipeline", jobHandler.UpdatePipeline)   // Configure hiring pipeline stages
+			employerJobs.POST("/:id/restore", jobHandler.RestoreJob)       // Restore a deleted job
 		}
 
 		// Employer dashboard
@@ -194,7 +360,33 @@ func main() {
 			applications.POST("", applicationHandler.ApplyToJob)                    // Apply to job
 			applications.GET("", applicationHandler.GetMyApplications)              // Get my applications
 			applications.GET("/stats", applicationHandler.GetMyApplicationStats)    // Get application stats
+			applications.GET("/withdrawn", applicationHandler.GetWithdrawnApplications) // Get withdrawn applications
+			applications.GET("/:id", applicationHandler.GetMyApplication)           // Get application with timeline
+			applications.GET("/:id/interviews", interviewHandler.GetApplicationInterviews) // Interviews for my application
 			applications.DELETE("/:id", applicationHandler.WithdrawApplication)     // Withdraw application
+			applications.POST("/:id/restore", applicationHandler.RestoreApplication) // Restore withdrawn application
+		}
+
+		// Job Seeker offer routes
+		offers := api.Group("/offers")
+		offers.Use(middleware.AuthRequired())
+		offers.Use(middleware.RequireRole("job_seeker"))
+		{
+			offers.GET("", offerHandler.GetMyOffers)                // Get my offers
+			offers.GET("/:id", offerHandler.GetMyOffer)             // Get offer details
+			offers.POST("/:id/accept", offerHandler.AcceptOffer)    // Accept an offer
+			offers.POST("/:id/decline", offerHandler.DeclineOffer)  // Decline an offer
+		}
+
+		// Invitations to apply, received by job seekers
+		invitations := api.Group("/invitations")
+		invitations.Use(middleware.AuthRequired())
+		invitations.Use(middleware.RequireRole("job_seeker"))
+		{
+			invitations.GET("", invitationHandler.GetMyInvitations)                // My invitations (?status=)
+			invitations.GET("/:id", invitationHandler.GetMyInvitation)             // Invitation with the job's questions
+			invitations.POST("/:id/accept", invitationHandler.AcceptInvitation)    // Accept and apply
+			invitations.POST("/:id/decline", invitationHandler.DeclineInvitation)  // Decline with an optional reason
 		}
 
 		// Employer Application Management routes
@@ -212,7 +404,56 @@ func main() {
 		applicationStatus.Use(middleware.RequireRole("employer"))
 		{
 			applicationStatus.PUT("/status", applicationHandler.UpdateApplicationStatus) // Update application status
+			applicationStatus.GET("/history", applicationHandler.GetApplicationHistory)  // Stage history
+		}
+
+		// Messaging between applicants and employers (participants only)
+		conversations := api.Group("/conversations")
+		conversations.Use(middleware.AuthRequired())
+		{
+			conversations.GET("", conversationHandler.GetConversations)                  // List my conversations
+			conversations.POST("", conversationHandler.StartConversation)                // Open the thread for an application
+			conversations.GET("/unread-count", conversationHandler.GetUnreadCount)       // Unread messages across threads
+			conversations.GET("/:id", conversationHandler.GetConversation)               // Messages (marks them read)
+			conversations.POST("/:id/messages", conversationHandler.SendMessage)         // Send a message with optional attachment
+			conversations.POST("/:id/read", conversationHandler.MarkConversationRead)    // Mark thread as read
 		}
+
+		// Interviews for the current user (candidate or employer)
+		interviews := api.Group("/interviews")
+		interviews.Use(middleware.AuthRequired())
+		{
+			interviews.GET("", interviewHandler.GetInterviews)                         // List my interviews
+			interviews.POST("/calendar-feed", interviewHandler.CreateCalendarFeed)     // Create (or rotate) my calendar subscription link
+			interviews.GET("/:id", interviewHandler.GetInterview)                      // Interview with proposed slots
+			interviews.POST("/:id/select", interviewHandler.SelectInterviewSlot)       // Candidate picks a slot
+			interviews.POST("/:id/reschedule", interviewHandler.RescheduleInterview)   // Employer proposes new slots
+			interviews.POST("/:id/cancel", interviewHandler.CancelInterview)           // Either side cancels
+			interviews.GET("/:id/calendar.ics", interviewHandler.DownloadCalendar)     // Download as a calendar file
+		}
+
+		// Shared portfolios, authorized by the link's secret token
+		api.GET("/portfolios/:token", portfolioHandler.GetSharedPortfolio)
+
+		// Public and unlisted job seeker profiles
+		api.GET("/profiles/:slug", publicProfileHandler.GetPublicProfile)
+
+		// Calendar feed for subscribing from calendar apps, authorized by its secret token
+		api.GET("/calendar/:token", interviewHandler.GetCalendarFeed)
+
+		// In-app notifications for the current user
+		notifications := api.Group("/notifications")
+		notifications.Use(middleware.AuthRequired())
+		{
+			notifications.GET("", notificationHandler.GetNotifications)              // List notifications (?unread=true)
+			notifications.GET("/unread-count", notificationHandler.GetUnreadCount)   // Unread notification count
+			notifications.POST("/read-all", notificationHandler.MarkAllRead)         // Mark every notification read
+			notifications.POST("/:id/read", notificationHandler.MarkRead)            // Mark one notification read
+			notifications.POST("/stream-token", notificationHandler.CreateStreamToken) // Short-lived token for the stream URL
+		}
+
+		// Live notification stream; EventSource passes a stream token as ?token=
+		api.GET("/notifications/stream", middleware.StreamAuthRequired(), notificationHandler.Stream)
 	}
 
 	// Get port from environment or use default
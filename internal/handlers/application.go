@@ -108,6 +108,99 @@ func (h *ApplicationHandler) GetMyApplications(c *gin.Context) {
 	})
 }
 
+func (h *ApplicationHandler) GetMyApplication(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	detail, err := h.applicationService.GetMyApplication(uint(applicationID), jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    detail,
+	})
+}
+
+// CheckEligibility lets a candidate see whether they can apply to a job,
+// and what to fix first, before filling in the application
+func (h *ApplicationHandler) CheckEligibility(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found. Create profile first.",
+		})
+		return
+	}
+
+	jobID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid job ID",
+		})
+		return
+	}
+
+	eligibility, err := h.applicationService.CheckEligibility(jobSeeker.ID, uint(jobID))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err.Error() == "job not found" {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    eligibility,
+	})
+}
+
 func (h *ApplicationHandler) GetJobApplications(c *gin.Context) {
 	userID, exists := middleware.GetCurrentUserID(c)
 	if !exists {
@@ -137,7 +230,16 @@ func (h *ApplicationHandler) GetJobApplications(c *gin.Context) {
 		return
 	}
 
-	applications, err := h.applicationService.GetJobApplications(uint(jobID), employer.ID)
+	var filters services.ApplicantFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	applications, pagination, err := h.applicationService.GetJobApplications(uint(jobID), employer.ID, filters)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"success": false,
@@ -147,8 +249,9 @@ func (h *ApplicationHandler) GetJobApplications(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"success": true,
-		"data":    applications,
+		"success":    true,
+		"data":       applications,
+		"pagination": pagination,
 	})
 }
 
@@ -326,4 +429,170 @@ func (h *ApplicationHandler) GetJobApplicationStats(c *gin.Context) {
 		"success": true,
 		"data":    stats,
 	})
-}
\ No newline at end of file
+}
+
+func (h *ApplicationHandler) GetWithdrawnApplications(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	applications, err := h.applicationService.GetWithdrawnApplications(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    applications,
+	})
+}
+
+func (h *ApplicationHandler) RestoreApplication(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	application, err := h.applicationService.RestoreApplication(uint(applicationID), jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Application restored successfully",
+		"data":    application,
+	})
+}
+func (h *ApplicationHandler) GetApplicationHistory(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	history, err := h.applicationService.GetApplicationHistory(uint(applicationID), employer.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    history,
+	})
+}
+
+func (h *ApplicationHandler) BulkUpdateApplicationStatus(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	var req services.BulkUpdateApplicationStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	result, err := h.applicationService.BulkUpdateApplicationStatus(employer.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Bulk status update processed",
+		"data":    result,
+	})
+}
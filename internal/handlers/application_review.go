@@ -0,0 +1,341 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ApplicationReviewHandler struct {
+	reviewService   services.ApplicationReviewService
+	employerService services.EmployerService
+}
+
+func NewApplicationReviewHandler(
+	reviewService services.ApplicationReviewService,
+	employerService services.EmployerService,
+) *ApplicationReviewHandler {
+	return &ApplicationReviewHandler{
+		reviewService:   reviewService,
+		employerService: employerService,
+	}
+}
+
+func (h *ApplicationReviewHandler) GetApplicationReview(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	review, err := h.reviewService.GetReview(employer.ID, uint(applicationID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    review,
+	})
+}
+
+func (h *ApplicationReviewHandler) AddApplicationNote(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	var req services.ApplicationNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	note, err := h.reviewService.AddNote(employer.ID, userID, uint(applicationID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Note added successfully",
+		"data":    note,
+	})
+}
+
+func (h *ApplicationReviewHandler) UpdateApplicationNote(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	noteID, err := strconv.ParseUint(c.Param("noteId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid note ID",
+		})
+		return
+	}
+
+	var req services.ApplicationNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	note, err := h.reviewService.UpdateNote(employer.ID, userID, uint(applicationID), uint(noteID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Note updated successfully",
+		"data":    note,
+	})
+}
+
+func (h *ApplicationReviewHandler) DeleteApplicationNote(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	noteID, err := strconv.ParseUint(c.Param("noteId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid note ID",
+		})
+		return
+	}
+
+	if err := h.reviewService.DeleteNote(employer.ID, userID, uint(applicationID), uint(noteID)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Note deleted successfully",
+	})
+}
+
+func (h *ApplicationReviewHandler) RateApplication(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	var req services.RateApplicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	review, err := h.reviewService.RateApplication(employer.ID, userID, uint(applicationID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Rating saved successfully",
+		"data":    review,
+	})
+}
+
+func (h *ApplicationReviewHandler) SetApplicationTags(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	var req services.SetApplicationTagsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	tags, err := h.reviewService.SetTags(employer.ID, userID, uint(applicationID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Tags updated successfully",
+		"data":    tags,
+	})
+}
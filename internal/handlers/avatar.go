@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AvatarHandler struct {
+	avatarService services.AvatarService
+}
+
+func NewAvatarHandler(avatarService services.AvatarService) *AvatarHandler {
+	return &AvatarHandler{
+		avatarService: avatarService,
+	}
+}
+
+func (h *AvatarHandler) UploadAvatar(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	file, err := c.FormFile("avatar")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "No file uploaded or invalid form data",
+		})
+		return
+	}
+
+	user, err := h.avatarService.UploadAvatar(userID, file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Photo uploaded successfully",
+		"data":    user,
+	})
+}
+
+func (h *AvatarHandler) RemoveAvatar(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	if err := h.avatarService.RemoveAvatar(userID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   "Failed to remove photo",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Photo removed",
+	})
+}
+
+// GetAvatar serves a user's avatar without auth so it can be used directly
+// in image tags. Photos are redirected to when the user's privacy settings
+// allow; otherwise an initials SVG is rendered. ?size=thumb returns the small
+// version.
+func (h *AvatarHandler) GetAvatar(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid user ID",
+		})
+		return
+	}
+
+	avatar, err := h.avatarService.GetAvatar(uint(userID), c.Query("size") == "thumb")
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	// Short caching so a new photo or name shows up soon after it changes
+	c.Header("Cache-Control", "public, max-age=300")
+	if avatar.RedirectURL != "" {
+		c.Redirect(http.StatusFound, avatar.RedirectURL)
+		return
+	}
+	c.Data(http.StatusOK, "image/svg+xml", []byte(avatar.SVG))
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CompanyHandler serves public employer pages; no authentication required
+type CompanyHandler struct {
+	companyService services.CompanyService
+}
+
+func NewCompanyHandler(companyService services.CompanyService) *CompanyHandler {
+	return &CompanyHandler{
+		companyService: companyService,
+	}
+}
+
+func (h *CompanyHandler) SearchCompanies(c *gin.Context) {
+	var filters services.CompanyFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	companies, pagination, err := h.companyService.SearchCompanies(filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       companies,
+		"pagination": pagination,
+	})
+}
+
+func (h *CompanyHandler) GetCompany(c *gin.Context) {
+	companyID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid company ID",
+		})
+		return
+	}
+
+	company, err := h.companyService.GetCompany(uint(companyID))
+	h.respondWithCompany(c, company, err)
+}
+
+func (h *CompanyHandler) GetCompanyBySlug(c *gin.Context) {
+	company, err := h.companyService.GetCompanyBySlug(c.Param("slug"))
+	h.respondWithCompany(c, company, err)
+}
+
+func (h *CompanyHandler) respondWithCompany(c *gin.Context, company *services.CompanyProfile, err error) {
+	if err != nil {
+		status := http.StatusInternalServerError
+		if err.Error() == "company not found" {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    company,
+	})
+}
@@ -0,0 +1,378 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InterviewHandler struct {
+	interviewService services.InterviewService
+}
+
+func NewInterviewHandler(interviewService services.InterviewService) *InterviewHandler {
+	return &InterviewHandler{
+		interviewService: interviewService,
+	}
+}
+
+func (h *InterviewHandler) ProposeInterview(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	var req services.ProposeInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	interview, err := h.interviewService.ProposeInterview(userID, uint(applicationID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Interview proposed",
+		"data":    interview,
+	})
+}
+
+func (h *InterviewHandler) GetInterviews(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	interviews, err := h.interviewService.GetInterviews(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    interviews,
+	})
+}
+
+func (h *InterviewHandler) GetApplicationInterviews(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	interviews, err := h.interviewService.GetApplicationInterviews(userID, uint(applicationID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    interviews,
+	})
+}
+
+func (h *InterviewHandler) GetInterview(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid interview ID",
+		})
+		return
+	}
+
+	interview, err := h.interviewService.GetInterview(userID, uint(interviewID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    interview,
+	})
+}
+
+func (h *InterviewHandler) SelectInterviewSlot(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid interview ID",
+		})
+		return
+	}
+
+	var req services.SelectInterviewSlotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	interview, err := h.interviewService.SelectSlot(userID, uint(interviewID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Interview scheduled",
+		"data":    interview,
+	})
+}
+
+func (h *InterviewHandler) RescheduleInterview(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid interview ID",
+		})
+		return
+	}
+
+	var req services.RescheduleInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	interview, err := h.interviewService.RescheduleInterview(userID, uint(interviewID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "New interview times proposed",
+		"data":    interview,
+	})
+}
+
+func (h *InterviewHandler) CancelInterview(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid interview ID",
+		})
+		return
+	}
+
+	var req services.CancelInterviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	interview, err := h.interviewService.CancelInterview(userID, uint(interviewID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Interview cancelled",
+		"data":    interview,
+	})
+}
+
+// DownloadCalendar returns the interview as an .ics file to add to a calendar
+func (h *InterviewHandler) DownloadCalendar(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	interviewID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid interview ID",
+		})
+		return
+	}
+
+	calendar, err := h.interviewService.GetCalendar(userID, uint(interviewID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="interview-%d.ics"`, interviewID))
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(calendar))
+}
+
+// CreateCalendarFeed issues a private URL calendar apps can subscribe to
+func (h *InterviewHandler) CreateCalendarFeed(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	token, err := h.interviewService.CreateCalendarToken(userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Calendar link created; any earlier link no longer works",
+		"data": gin.H{
+			"feed_url": fmt.Sprintf("%s://%s/api/calendar/%s.ics", scheme, c.Request.Host, token),
+		},
+	})
+}
+
+// GetCalendarFeed serves the subscribed calendar. The token in the URL is the
+// only credential since calendar apps can't send auth headers.
+func (h *InterviewHandler) GetCalendarFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	calendar, err := h.interviewService.GetCalendarFeed(token)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(calendar))
+}
@@ -0,0 +1,314 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InvitationHandler struct {
+	invitationService services.InvitationService
+	jobSeekerService  services.JobSeekerService
+	employerService   services.EmployerService
+}
+
+func NewInvitationHandler(
+	invitationService services.InvitationService,
+	jobSeekerService services.JobSeekerService,
+	employerService services.EmployerService,
+) *InvitationHandler {
+	return &InvitationHandler{
+		invitationService: invitationService,
+		jobSeekerService:  jobSeekerService,
+		employerService:   employerService,
+	}
+}
+
+func (h *InvitationHandler) CreateInvitation(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	var req services.CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(employer.ID, userID, req)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, services.ErrInvitationLimitReached) {
+			status = http.StatusTooManyRequests
+		}
+		c.JSON(status, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Invitation sent",
+		"data":    invitation,
+	})
+}
+
+func (h *InvitationHandler) GetSentInvitations(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	var filters services.SentInvitationFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	invitations, err := h.invitationService.GetSentInvitations(employer.ID, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    invitations,
+	})
+}
+
+func (h *InvitationHandler) GetMyInvitations(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	var filters services.InvitationFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	invitations, err := h.invitationService.GetMyInvitations(jobSeeker.ID, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    invitations,
+	})
+}
+
+func (h *InvitationHandler) GetMyInvitation(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	invitationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid invitation ID",
+		})
+		return
+	}
+
+	invitation, err := h.invitationService.GetMyInvitation(jobSeeker.ID, uint(invitationID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    invitation,
+	})
+}
+
+func (h *InvitationHandler) AcceptInvitation(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	invitationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid invitation ID",
+		})
+		return
+	}
+
+	var req services.AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	invitation, err := h.invitationService.AcceptInvitation(jobSeeker.ID, uint(invitationID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Invitation accepted and application submitted",
+		"data":    invitation,
+	})
+}
+
+func (h *InvitationHandler) DeclineInvitation(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	invitationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid invitation ID",
+		})
+		return
+	}
+
+	var req services.DeclineInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	invitation, err := h.invitationService.DeclineInvitation(jobSeeker.ID, uint(invitationID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Invitation declined",
+		"data":    invitation,
+	})
+}
@@ -0,0 +1,359 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OfferHandler struct {
+	offerService     services.OfferService
+	jobSeekerService services.JobSeekerService
+	employerService  services.EmployerService
+}
+
+func NewOfferHandler(
+	offerService services.OfferService,
+	jobSeekerService services.JobSeekerService,
+	employerService services.EmployerService,
+) *OfferHandler {
+	return &OfferHandler{
+		offerService:     offerService,
+		jobSeekerService: jobSeekerService,
+		employerService:  employerService,
+	}
+}
+
+// CreateOffer accepts JSON, or multipart form data when an offer letter is attached
+func (h *OfferHandler) CreateOffer(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	var req services.CreateOfferRequest
+	if err := c.ShouldBind(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	document, err := c.FormFile("document")
+	if err != nil {
+		if strings.HasPrefix(c.ContentType(), "multipart/") && err != http.ErrMissingFile {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "Invalid offer document",
+			})
+			return
+		}
+		document = nil
+	}
+
+	offer, err := h.offerService.CreateOffer(employer.ID, userID, uint(applicationID), req, document)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Offer sent",
+		"data":    offer,
+	})
+}
+
+func (h *OfferHandler) GetApplicationOffers(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	applicationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid application ID",
+		})
+		return
+	}
+
+	offers, err := h.offerService.GetApplicationOffers(employer.ID, uint(applicationID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    offers,
+	})
+}
+
+func (h *OfferHandler) WithdrawOffer(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	offerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid offer ID",
+		})
+		return
+	}
+
+	offer, err := h.offerService.WithdrawOffer(employer.ID, uint(offerID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Offer withdrawn",
+		"data":    offer,
+	})
+}
+
+func (h *OfferHandler) GetMyOffers(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	offers, err := h.offerService.GetMyOffers(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    offers,
+	})
+}
+
+func (h *OfferHandler) GetMyOffer(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	offerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid offer ID",
+		})
+		return
+	}
+
+	offer, err := h.offerService.GetMyOffer(jobSeeker.ID, uint(offerID))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    offer,
+	})
+}
+
+func (h *OfferHandler) AcceptOffer(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	offerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid offer ID",
+		})
+		return
+	}
+
+	offer, err := h.offerService.AcceptOffer(jobSeeker.ID, uint(offerID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Offer accepted",
+		"data":    offer,
+	})
+}
+
+func (h *OfferHandler) DeclineOffer(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	offerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid offer ID",
+		})
+		return
+	}
+
+	var req services.DeclineOfferRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	offer, err := h.offerService.DeclineOffer(jobSeeker.ID, uint(offerID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Offer declined",
+		"data":    offer,
+	})
+}
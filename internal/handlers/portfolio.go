@@ -0,0 +1,496 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PortfolioHandler struct {
+	portfolioService services.PortfolioService
+	jobSeekerService services.JobSeekerService
+}
+
+func NewPortfolioHandler(portfolioService services.PortfolioService, jobSeekerService services.JobSeekerService) *PortfolioHandler {
+	return &PortfolioHandler{
+		portfolioService: portfolioService,
+		jobSeekerService: jobSeekerService,
+	}
+}
+
+func (h *PortfolioHandler) GetProjects(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	projects, err := h.portfolioService.GetProjects(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    projects,
+	})
+}
+
+func (h *PortfolioHandler) CreateProject(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	var req services.PortfolioProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	project, err := h.portfolioService.CreateProject(jobSeeker.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Project added to portfolio",
+		"data":    project,
+	})
+}
+
+func (h *PortfolioHandler) UpdateProject(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid project ID",
+		})
+		return
+	}
+
+	var req services.PortfolioProjectRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	project, err := h.portfolioService.UpdateProject(jobSeeker.ID, uint(projectID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Project updated successfully",
+		"data":    project,
+	})
+}
+
+func (h *PortfolioHandler) DeleteProject(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid project ID",
+		})
+		return
+	}
+
+	if err := h.portfolioService.DeleteProject(jobSeeker.ID, uint(projectID)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Project deleted successfully",
+	})
+}
+
+// ReorderProjects takes every project ID in the order they should be shown
+func (h *PortfolioHandler) ReorderProjects(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	var req services.ReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	projects, err := h.portfolioService.ReorderProjects(jobSeeker.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Portfolio reordered",
+		"data":    projects,
+	})
+}
+
+// UploadMedia accepts one or more "media" files (images or PDF pages), each
+// with an optional matching "captions" form value
+func (h *PortfolioHandler) UploadMedia(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid project ID",
+		})
+		return
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil || len(form.File["media"]) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "No file uploaded or invalid form data",
+		})
+		return
+	}
+
+	project, err := h.portfolioService.UploadMedia(jobSeeker.ID, userID, uint(projectID), form.File["media"], form.Value["captions"])
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Media uploaded successfully",
+		"data":    project,
+	})
+}
+
+// ReorderMedia takes every media ID of the project in the order they should be shown
+func (h *PortfolioHandler) ReorderMedia(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid project ID",
+		})
+		return
+	}
+
+	var req services.ReorderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	project, err := h.portfolioService.ReorderMedia(jobSeeker.ID, uint(projectID), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Media reordered",
+		"data":    project,
+	})
+}
+
+func (h *PortfolioHandler) DeleteMedia(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	projectID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid project ID",
+		})
+		return
+	}
+
+	mediaID, err := strconv.ParseUint(c.Param("mediaId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   "Invalid media ID",
+		})
+		return
+	}
+
+	if err := h.portfolioService.DeleteMedia(jobSeeker.ID, uint(projectID), uint(mediaID)); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Media deleted successfully",
+	})
+}
+
+// CreateShareLink issues a public portfolio link, replacing any earlier one
+func (h *PortfolioHandler) CreateShareLink(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	token, err := h.portfolioService.CreateShareToken(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Portfolio link created; any earlier link no longer works",
+		"data": gin.H{
+			"share_url": fmt.Sprintf("%s://%s/api/portfolios/%s", scheme, c.Request.Host, token),
+		},
+	})
+}
+
+func (h *PortfolioHandler) DisableSharing(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	if err := h.portfolioService.DisableSharing(jobSeeker.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Portfolio link turned off",
+	})
+}
+
+// GetSharedPortfolio serves a public portfolio link. The token in the URL is
+// the only credential.
+func (h *PortfolioHandler) GetSharedPortfolio(c *gin.Context) {
+	portfolio, err := h.portfolioService.GetSharedPortfolio(c.Param("token"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    portfolio,
+	})
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
@@ -10,23 +11,29 @@ import (
 )
 
 type ProfileExtensionHandler struct {
-	studentProfileService services.StudentProfileService
-	firmProfileService    services.FirmProfileService
-	jobSeekerService      services.JobSeekerService
-	employerService       services.EmployerService
+	studentProfileService      services.StudentProfileService
+	professionalProfileService services.ProfessionalProfileService
+	freelancerProfileService   services.FreelancerProfileService
+	firmProfileService         services.FirmProfileService
+	jobSeekerService           services.JobSeekerService
+	employerService            services.EmployerService
 }
 
 func NewProfileExtensionHandler(
 	studentProfileService services.StudentProfileService,
+	professionalProfileService services.ProfessionalProfileService,
+	freelancerProfileService services.FreelancerProfileService,
 	firmProfileService services.FirmProfileService,
 	jobSeekerService services.JobSeekerService,
 	employerService services.EmployerService,
 ) *ProfileExtensionHandler {
 	return &ProfileExtensionHandler{
-		studentProfileService: studentProfileService,
-		firmProfileService:    firmProfileService,
-		jobSeekerService:      jobSeekerService,
-		employerService:       employerService,
+		studentProfileService:      studentProfileService,
+		professionalProfileService: professionalProfileService,
+		freelancerProfileService:   freelancerProfileService,
+		firmProfileService:         firmProfileService,
+		jobSeekerService:           jobSeekerService,
+		employerService:            employerService,
 	}
 }
 
@@ -191,6 +198,620 @@ func (h *ProfileExtensionHandler) DeleteStudentProfile(c *gin.Context) {
 	})
 }
 
+func (h *ProfileExtensionHandler) RestoreStudentProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	profile, err := h.studentProfileService.RestoreProfile(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Student profile restored successfully",
+		"data":    profile,
+	})
+}
+
+// Student profile entries. Internships and freelance projects share these
+// handlers; each route is bound to one entry type.
+
+func (h *ProfileExtensionHandler) GetStudentEntries(entryType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := middleware.GetCurrentUserID(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "User not authenticated",
+			})
+			return
+		}
+
+		jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Job seeker profile not found",
+			})
+			return
+		}
+
+		entries, err := h.studentProfileService.GetEntries(jobSeeker.ID, entryType)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"data":    entries,
+		})
+	}
+}
+
+func (h *ProfileExtensionHandler) AddStudentEntry(entryType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := middleware.GetCurrentUserID(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "User not authenticated",
+			})
+			return
+		}
+
+		jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Job seeker profile not found",
+			})
+			return
+		}
+
+		var req services.StudentProfileEntryRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		entry, err := h.studentProfileService.AddEntry(jobSeeker.ID, entryType, req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{
+			"success": true,
+			"message": "Entry added successfully",
+			"data":    entry,
+		})
+	}
+}
+
+func (h *ProfileExtensionHandler) UpdateStudentEntry(entryType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := middleware.GetCurrentUserID(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "User not authenticated",
+			})
+			return
+		}
+
+		entryID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "Invalid entry ID",
+			})
+			return
+		}
+
+		jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Job seeker profile not found",
+			})
+			return
+		}
+
+		var req services.StudentProfileEntryRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		entry, err := h.studentProfileService.UpdateEntry(jobSeeker.ID, entryType, uint(entryID), req)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"message": "Entry updated successfully",
+			"data":    entry,
+		})
+	}
+}
+
+func (h *ProfileExtensionHandler) DeleteStudentEntry(entryType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := middleware.GetCurrentUserID(c)
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"success": false,
+				"error":   "User not authenticated",
+			})
+			return
+		}
+
+		entryID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   "Invalid entry ID",
+			})
+			return
+		}
+
+		jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+		if err != nil {
+			c.JSON(http.StatusNotFound, gin.H{
+				"success": false,
+				"error":   "Job seeker profile not found",
+			})
+			return
+		}
+
+		if err := h.studentProfileService.DeleteEntry(jobSeeker.ID, entryType, uint(entryID)); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"success": false,
+				"error":   err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"success": true,
+			"message": "Entry deleted successfully",
+		})
+	}
+}
+
+// Professional Profile Extension Handlers
+
+func (h *ProfileExtensionHandler) CreateProfessionalProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found. Create profile first.",
+		})
+		return
+	}
+
+	var req services.CreateProfessionalProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	profile, err := h.professionalProfileService.CreateProfile(jobSeeker.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Professional profile created successfully",
+		"data":    profile,
+	})
+}
+
+func (h *ProfileExtensionHandler) GetProfessionalProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	profile, err := h.professionalProfileService.GetProfile(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    profile,
+	})
+}
+
+func (h *ProfileExtensionHandler) UpdateProfessionalProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	var req services.UpdateProfessionalProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	profile, err := h.professionalProfileService.UpdateProfile(jobSeeker.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Professional profile updated successfully",
+		"data":    profile,
+	})
+}
+
+func (h *ProfileExtensionHandler) DeleteProfessionalProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	if err := h.professionalProfileService.DeleteProfile(jobSeeker.ID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Professional profile deleted successfully",
+	})
+}
+
+func (h *ProfileExtensionHandler) RestoreProfessionalProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	profile, err := h.professionalProfileService.RestoreProfile(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Professional profile restored successfully",
+		"data":    profile,
+	})
+}
+
+// Freelancer Profile Extension Handlers
+
+func (h *ProfileExtensionHandler) CreateFreelancerProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found. Create profile first.",
+		})
+		return
+	}
+
+	var req services.CreateFreelancerProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	profile, err := h.freelancerProfileService.CreateProfile(jobSeeker.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"success": true,
+		"message": "Freelancer profile created successfully",
+		"data":    profile,
+	})
+}
+
+func (h *ProfileExtensionHandler) GetFreelancerProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	profile, err := h.freelancerProfileService.GetProfile(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    profile,
+	})
+}
+
+func (h *ProfileExtensionHandler) UpdateFreelancerProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	var req services.UpdateFreelancerProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	profile, err := h.freelancerProfileService.UpdateProfile(jobSeeker.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Freelancer profile updated successfully",
+		"data":    profile,
+	})
+}
+
+func (h *ProfileExtensionHandler) DeleteFreelancerProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	if err := h.freelancerProfileService.DeleteProfile(jobSeeker.ID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Freelancer profile deleted successfully",
+	})
+}
+
+func (h *ProfileExtensionHandler) RestoreFreelancerProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get job seeker profile
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	profile, err := h.freelancerProfileService.RestoreProfile(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Freelancer profile restored successfully",
+		"data":    profile,
+	})
+}
+
 // Firm Profile Extension Handlers
 
 func (h *ProfileExtensionHandler) CreateFirmProfile(c *gin.Context) {
@@ -352,6 +973,42 @@ func (h *ProfileExtensionHandler) DeleteFirmProfile(c *gin.Context) {
 	})
 }
 
+func (h *ProfileExtensionHandler) RestoreFirmProfile(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Get employer profile
+	employer, err := h.employerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	profile, err := h.firmProfileService.RestoreProfile(employer.ID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Firm profile restored successfully",
+		"data":    profile,
+	})
+}
+
 // Enhanced Profile Endpoints with Extensions
 
 func (h *ProfileExtensionHandler) GetJobSeekerProfileWithExtensions(c *gin.Context) {
@@ -381,12 +1038,27 @@ func (h *ProfileExtensionHandler) GetJobSeekerProfileWithExtensions(c *gin.Conte
 		},
 	}
 
-	// Try to get student profile if job seeker is a student
-	if jobSeeker.JobSeekerType == "student" {
+	if completeness, err := h.jobSeekerService.GetProfileCompleteness(userID); err == nil {
+		response["data"].(gin.H)["completeness"] = completeness
+	}
+
+	// Attach the extension matching the job seeker's type, if they have one
+	switch jobSeeker.JobSeekerType {
+	case "student":
 		studentProfile, err := h.studentProfileService.GetProfile(jobSeeker.ID)
 		if err == nil {
 			response["data"].(gin.H)["student_profile"] = studentProfile
 		}
+	case "professional":
+		professionalProfile, err := h.professionalProfileService.GetProfile(jobSeeker.ID)
+		if err == nil {
+			response["data"].(gin.H)["professional_profile"] = professionalProfile
+		}
+	case "freelancer":
+		freelancerProfile, err := h.freelancerProfileService.GetProfile(jobSeeker.ID)
+		if err == nil {
+			response["data"].(gin.H)["freelancer_profile"] = freelancerProfile
+		}
 	}
 
 	c.JSON(http.StatusOK, response)
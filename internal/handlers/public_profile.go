@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PublicProfileHandler struct {
+	publicProfileService services.PublicProfileService
+	jobSeekerService     services.JobSeekerService
+}
+
+func NewPublicProfileHandler(publicProfileService services.PublicProfileService, jobSeekerService services.JobSeekerService) *PublicProfileHandler {
+	return &PublicProfileHandler{
+		publicProfileService: publicProfileService,
+		jobSeekerService:     jobSeekerService,
+	}
+}
+
+func (h *PublicProfileHandler) GetSettings(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	settings, err := h.publicProfileService.GetSettings(jobSeeker.ID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    settings,
+	})
+}
+
+func (h *PublicProfileHandler) UpdateSettings(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	jobSeeker, err := h.jobSeekerService.GetProfile(userID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Job seeker profile not found",
+		})
+		return
+	}
+
+	var req services.PublicProfileSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	settings, err := h.publicProfileService.UpdateSettings(jobSeeker.ID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"message": "Public profile settings updated",
+		"data":    settings,
+	})
+}
+
+// GetPublicProfile serves a job seeker's public profile. Unlisted profiles are
+// served to anyone with the link but ask search engines not to index them.
+func (h *PublicProfileHandler) GetPublicProfile(c *gin.Context) {
+	profile, err := h.publicProfileService.GetPublicProfile(c.Param("slug"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	if !profile.Indexable {
+		c.Header("X-Robots-Tag", "noindex")
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    profile,
+	})
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/middleware"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+type TalentHandler struct {
+	talentService   services.TalentService
+	employerService services.EmployerService
+}
+
+func NewTalentHandler(talentService services.TalentService, employerService services.EmployerService) *TalentHandler {
+	return &TalentHandler{
+		talentService:   talentService,
+		employerService: employerService,
+	}
+}
+
+func (h *TalentHandler) SearchTalent(c *gin.Context) {
+	userID, exists := middleware.GetCurrentUserID(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "User not authenticated",
+		})
+		return
+	}
+
+	// Only employers with a profile can browse candidates
+	if _, err := h.employerService.GetProfile(userID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"success": false,
+			"error":   "Employer profile not found",
+		})
+		return
+	}
+
+	var filters services.TalentFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	candidates, pagination, err := h.talentService.SearchTalent(filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"success": false,
+			"error":   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":    true,
+		"data":       candidates,
+		"pagination": pagination,
+	})
+}
@@ -31,21 +31,51 @@ func AuthRequired() gin.HandlerFunc {
 			return
 		}
 
-		claims, err := utils.ValidateJWT(tokenString)
-		if err != nil {
+		authenticate(c, tokenString, "")
+	}
+}
+
+// StreamAuthRequired is AuthRequired for EventSource connections. Browsers
+// can't set headers on those, so a stream token (see utils.GenerateStreamToken)
+// may come from ?token= instead. Login tokens are only accepted in the header.
+func StreamAuthRequired() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if tokenString := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "); tokenString != "" {
+			authenticate(c, tokenString, "")
+			return
+		}
+
+		tokenString := c.Query("token")
+		if tokenString == "" {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"success": false,
-				"error":   "Invalid token",
+				"error":   "Token required",
 			})
 			c.Abort()
 			return
 		}
 
-		c.Set("user_id", claims.UserID)
-		c.Set("email", claims.Email)
-		c.Set("user_type", claims.UserType)
-		c.Next()
+		authenticate(c, tokenString, utils.StreamTokenPurpose)
+	}
+}
+
+// authenticate accepts only tokens issued for purpose, so a leaked stream
+// token can't be used as a login token or the other way round
+func authenticate(c *gin.Context, tokenString string, purpose string) {
+	claims, err := utils.ValidateJWT(tokenString)
+	if err != nil || claims.Purpose != purpose {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"success": false,
+			"error":   "Invalid token",
+		})
+		c.Abort()
+		return
 	}
+
+	c.Set("user_id", claims.UserID)
+	c.Set("email", claims.Email)
+	c.Set("user_type", claims.UserType)
+	c.Next()
 }
 
 func RequireRole(userType string) gin.HandlerFunc {
@@ -87,4 +117,12 @@ func GetCurrentUserType(c *gin.Context) (string, bool) {
 		return "", false
 	}
 	return userType.(string), true
-}
\ No newline at end of file
+}
+
+func GetCurrentEmail(c *gin.Context) (string, bool) {
+	email, exists := c.Get("email")
+	if !exists {
+		return "", false
+	}
+	return email.(string), true
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// redactedQueryParams are query parameters that carry credentials
+var redactedQueryParams = []string{"token"}
+
+// RequestLogger is gin's request logger with credentials in query strings
+// (such as the notification stream's ?token=) redacted.
+func RequestLogger() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(param gin.LogFormatterParams) string {
+		return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %#v\n%s",
+			param.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			param.StatusCode,
+			param.Latency,
+			param.ClientIP,
+			param.Method,
+			redactQuery(param.Path),
+			param.ErrorMessage,
+		)
+	})
+}
+
+func redactQuery(path string) string {
+	base, rawQuery, found := strings.Cut(path, "?")
+	if !found {
+		return path
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return base + "?[unparseable query]"
+	}
+
+	redacted := false
+	for _, name := range redactedQueryParams {
+		if query.Has(name) {
+			query.Set(name, "REDACTED")
+			redacted = true
+		}
+	}
+	if !redacted {
+		return path
+	}
+	return base + "?" + query.Encode()
+}
@@ -2,15 +2,89 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Application struct {
-	ID          uint      `gorm:"primaryKey" json:"id"`
-	JobID       uint      `gorm:"not null" json:"job_id"`
-	Job         Job       `gorm:"foreignKey:JobID" json:"job,omitempty"`
-	JobSeekerID uint      `gorm:"not null" json:"job_seeker_id"`
-	JobSeeker   JobSeeker `gorm:"foreignKey:JobSeekerID" json:"job_seeker,omitempty"`
-	Status      string    `gorm:"default:'applied'" json:"status" validate:"oneof=applied shortlisted rejected selected"`
-	AppliedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP" json:"applied_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
-}
\ No newline at end of file
+	ID           uint           `gorm:"primaryKey" json:"id"`
+	JobID        uint           `gorm:"not null" json:"job_id"`
+	Job          Job            `gorm:"foreignKey:JobID" json:"job,omitempty"`
+	JobSeekerID  uint           `gorm:"not null" json:"job_seeker_id"`
+	JobSeeker    JobSeeker      `gorm:"foreignKey:JobSeekerID" json:"job_seeker,omitempty"`
+	Status       string         `gorm:"default:'applied'" json:"status" validate:"oneof=applied shortlisted rejected selected"`
+	Stage        string         `gorm:"not null" json:"stage"` // key of the job's pipeline stage
+	CoverLetter  string         `gorm:"type:text" json:"cover_letter"`
+	AutoRejected bool           `gorm:"not null;default:false" json:"auto_rejected"`
+	Source       string         `gorm:"not null;default:direct" json:"source" validate:"oneof=direct invited"`
+	AppliedAt    time.Time      `gorm:"default:CURRENT_TIMESTAMP" json:"applied_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+
+	// Computed by applicant search
+	MatchScore float64 `gorm:"->;-:migration" json:"match_score,omitempty"` // share of the job's required skills the candidate has
+	Rating     int     `gorm:"->;-:migration" json:"rating,omitempty"`      // the employer's 1-5 rating
+
+	// Relationships
+	Answers []ApplicationAnswer `gorm:"foreignKey:ApplicationID" json:"answers,omitempty"`
+	Tags    []ApplicationTag    `gorm:"foreignKey:ApplicationID" json:"tags,omitempty"` // employer-only, loaded by applicant search
+
+	// Portfolio projects the candidate chose to show with this application
+	PortfolioProjects []PortfolioProject `gorm:"many2many:application_portfolio_projects" json:"portfolio_projects,omitempty"`
+
+	// Material job changes made after the candidate applied (not persisted)
+	JobChangesSinceApplied []JobFieldChange `gorm:"-" json:"job_changes_since_applied,omitempty"`
+}
+
+// ApplicationAnswer is a candidate's answer to one of the job's screening questions
+type ApplicationAnswer struct {
+	ID                  uint              `gorm:"primaryKey" json:"id"`
+	ApplicationID       uint              `gorm:"not null" json:"application_id"`
+	ScreeningQuestionID uint              `gorm:"not null" json:"screening_question_id"`
+	Question            ScreeningQuestion `gorm:"foreignKey:ScreeningQuestionID" json:"question,omitempty"`
+	Answer              string            `gorm:"type:text" json:"answer"`
+	Passed              bool              `gorm:"not null" json:"passed"` // false when a knockout rule failed
+	CreatedAt           time.Time         `json:"created_at"`
+}
+
+// ApplicationStatusChange records a candidate moving between pipeline stages
+type ApplicationStatusChange struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	ApplicationID   uint      `gorm:"not null" json:"application_id"`
+	FromStage       string    `json:"from_stage"`
+	ToStage         string    `gorm:"not null" json:"to_stage"`
+	FromStatus      string    `json:"from_status"`
+	ToStatus        string    `gorm:"not null" json:"to_status"`
+	ChangedByUserID uint      `gorm:"not null" json:"changed_by_user_id"`
+	CandidateNote   string    `gorm:"type:text" json:"candidate_note"` // shown to the candidate on their timeline
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// ApplicationNote is a private note the hiring team keeps on an application
+type ApplicationNote struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	ApplicationID uint      `gorm:"not null" json:"application_id"`
+	AuthorUserID  uint      `gorm:"not null" json:"author_user_id"`
+	Body          string    `gorm:"type:text;not null" json:"body"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ApplicationRating is the employer's 1-5 star rating of an application
+type ApplicationRating struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	ApplicationID uint      `gorm:"not null" json:"application_id"`
+	RaterUserID   uint      `gorm:"not null" json:"rater_user_id"`
+	Rating        int       `gorm:"not null" json:"rating" validate:"min=1,max=5"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ApplicationTag is a free-form label the hiring team puts on an application
+type ApplicationTag struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	ApplicationID   uint      `gorm:"not null" json:"application_id"`
+	Tag             string    `gorm:"not null" json:"tag"`
+	CreatedByUserID uint      `gorm:"not null" json:"created_by_user_id"`
+	CreatedAt       time.Time `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Conversation is the message thread between an applicant and the employer
+// that owns the job they applied to. There is at most one per application.
+type Conversation struct {
+	ID            uint        `gorm:"primaryKey" json:"id"`
+	ApplicationID uint        `gorm:"uniqueIndex;not null" json:"application_id"`
+	Application   Application `gorm:"foreignKey:ApplicationID" json:"application,omitempty"`
+	LastMessageAt *time.Time  `json:"last_message_at"`
+	CreatedAt     time.Time   `json:"created_at"`
+	UpdatedAt     time.Time   `json:"updated_at"`
+
+	// Unread messages for the requesting user, computed when listing threads
+	UnreadCount int64 `gorm:"->;-:migration" json:"unread_count"`
+
+	// Relationships
+	Messages []Message `gorm:"foreignKey:ConversationID" json:"messages,omitempty"`
+}
+
+type Message struct {
+	ID             uint       `gorm:"primaryKey" json:"id"`
+	ConversationID uint       `gorm:"not null" json:"conversation_id"`
+	SenderUserID   uint       `gorm:"not null" json:"sender_user_id"`
+	Body           string     `gorm:"type:text" json:"body"`
+	AttachmentURL  string     `json:"attachment_url,omitempty"`
+	AttachmentName string     `json:"attachment_name,omitempty"`
+	ReadAt         *time.Time `json:"read_at"` // set when the other participant reads it
+	CreatedAt      time.Time  `json:"created_at"`
+
+	SenderAvatarURL string `gorm:"-" json:"sender_avatar_url"` // set by AfterFind and AfterCreate
+}
+
+func (m *Message) AfterFind(tx *gorm.DB) error {
+	m.SenderAvatarURL = AvatarPath(m.SenderUserID)
+	return nil
+}
+
+func (m *Message) AfterCreate(tx *gorm.DB) error {
+	m.SenderAvatarURL = AvatarPath(m.SenderUserID)
+	return nil
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// Outbox email statuses
+const (
+	EmailStatusPending = "pending"
+	EmailStatusSent    = "sent"
+	EmailStatusFailed  = "failed"
+)
+
+// OutboxEmail is a rendered email waiting to be delivered. Emails are written
+// here first and sent by a background worker so a mail server outage never
+// loses a message or fails the request that triggered it.
+type OutboxEmail struct {
+	ID            uint       `gorm:"primaryKey" json:"id"`
+	ToEmail       string     `gorm:"not null" json:"to_email"`
+	Template      string     `gorm:"not null" json:"template"`
+	Locale        string     `gorm:"not null" json:"locale"`
+	Subject       string     `gorm:"not null" json:"subject"`
+	HTMLBody      string     `gorm:"column:html_body;type:text;not null" json:"html_body"`
+	TextBody      string     `gorm:"type:text;not null" json:"text_body"`
+	Status        string     `gorm:"not null;default:pending" json:"status" validate:"oneof=pending sent failed"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt time.Time  `gorm:"not null" json:"next_attempt_at"`
+	LastError     string     `gorm:"type:text" json:"last_error,omitempty"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func (OutboxEmail) TableName() string {
+	return "email_outbox"
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+)
+
+// Interview statuses
+const (
+	InterviewStatusProposed  = "proposed"
+	InterviewStatusScheduled = "scheduled"
+	InterviewStatusCancelled = "cancelled"
+)
+
+// Interview is arranged by the employer for an application. The employer
+// proposes slots, the candidate picks one and the interview is scheduled.
+// Slot times are stored in UTC; Timezone is the zone the interview is
+// arranged in and is used when showing times to people.
+type Interview struct {
+	ID                uint        `gorm:"primaryKey" json:"id"`
+	ApplicationID     uint        `gorm:"not null;index" json:"application_id"`
+	Application       Application `gorm:"foreignKey:ApplicationID" json:"application,omitempty"`
+	Status            string      `gorm:"not null;default:proposed" json:"status" validate:"oneof=proposed scheduled cancelled"`
+	Mode              string      `gorm:"not null" json:"mode" validate:"oneof=online on_site"`
+	MeetingURL        string      `json:"meeting_url,omitempty"`
+	Location          string      `json:"location,omitempty"`
+	Timezone          string      `gorm:"not null" json:"timezone"`
+	DurationMinutes   int         `gorm:"not null" json:"duration_minutes"`
+	Notes             string      `gorm:"type:text" json:"notes"`
+	SelectedSlotID    *uint       `json:"selected_slot_id"`
+	ScheduledAt       *time.Time  `json:"scheduled_at"`
+	Sequence          int         `gorm:"not null;default:0" json:"-"` // bumped on every change so calendars update the event
+	CancelReason      string      `gorm:"type:text" json:"cancel_reason,omitempty"`
+	CancelledByUserID *uint       `json:"cancelled_by_user_id,omitempty"`
+	ReminderSentAt    *time.Time  `json:"-"`
+	CreatedByUserID   uint        `gorm:"not null" json:"created_by_user_id"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+
+	// Scheduled time in the interview's timezone (not persisted)
+	LocalScheduledAt string `gorm:"-" json:"local_scheduled_at,omitempty"`
+
+	// Relationships
+	Slots []InterviewSlot `gorm:"foreignKey:InterviewID" json:"slots,omitempty"`
+}
+
+// InterviewSlot is one time the employer is available for the interview
+type InterviewSlot struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	InterviewID uint      `gorm:"not null" json:"interview_id"`
+	StartsAt    time.Time `gorm:"not null" json:"starts_at"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Start time in the interview's timezone (not persisted)
+	LocalStartsAt string `gorm:"-" json:"local_starts_at,omitempty"`
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+)
+
+// Invitation statuses
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+	InvitationStatusExpired  = "expired"
+)
+
+// Application sources
+const (
+	ApplicationSourceDirect  = "direct"
+	ApplicationSourceInvited = "invited"
+)
+
+// Invitation asks a candidate found in talent search to apply to a job.
+// Accepting it submits an application on the candidate's behalf.
+type Invitation struct {
+	ID              uint       `gorm:"primaryKey" json:"id"`
+	JobID           uint       `gorm:"not null" json:"job_id"`
+	Job             Job        `gorm:"foreignKey:JobID" json:"job,omitempty"`
+	JobSeekerID     uint       `gorm:"not null;index" json:"job_seeker_id"`
+	JobSeeker       *JobSeeker `gorm:"foreignKey:JobSeekerID" json:"job_seeker,omitempty"`
+	EmployerID      uint       `gorm:"not null" json:"employer_id"`
+	InvitedByUserID uint       `gorm:"not null" json:"invited_by_user_id"`
+	Status          string     `gorm:"not null;default:pending" json:"status" validate:"oneof=pending accepted declined expired"`
+	Message         string     `gorm:"type:text" json:"message"`
+	ExpiresAt       time.Time  `gorm:"not null" json:"expires_at"`
+	RespondedAt     *time.Time `json:"responded_at"`
+	DeclineReason   string     `gorm:"type:text" json:"decline_reason,omitempty"`
+	ApplicationID   *uint      `json:"application_id"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Job struct {
@@ -23,14 +25,75 @@ type Job struct {
 	RequiredSkills      string          `gorm:"type:json;not null" json:"required_skills" validate:"required"`
 	MinExperience       string          `json:"min_experience"`
 	PortfolioRequired   bool            `gorm:"not null" json:"portfolio_required"`
-	ResumeRequired      bool            `gorm:"default:true" json:"resume_required"`
+	ResumeRequired      bool            `gorm:"not null" json:"resume_required"`
 	Description         string          `gorm:"type:text;not null" json:"description" validate:"required"`
 	AboutTeam           string          `gorm:"type:text" json:"about_team"`
 	ContactEmail        string          `gorm:"not null" json:"contact_email" validate:"required,email"`
-	IsActive            bool            `gorm:"default:true" json:"is_active"`
+	IsActive            bool            `gorm:"not null" json:"is_active"`
+	IsDraft             bool            `gorm:"not null;default:false" json:"is_draft"`
+	Openings            int             `gorm:"not null;default:1" json:"openings"`
+	ClosingNoticeAt     *time.Time      `json:"-"` // when the "closing soon" notification went out
+	ExpiryNoticeAt      *time.Time      `json:"-"` // when the employer was emailed that the deadline passed
 	CreatedAt           time.Time       `json:"created_at"`
 	UpdatedAt           time.Time       `json:"updated_at"`
+	DeletedAt           gorm.DeletedAt  `gorm:"index" json:"deleted_at"`
+
+	// Each selected candidate fills an opening; computed when jobs are loaded
+	FilledOpenings    int64 `gorm:"->;-:migration" json:"filled_openings"`
+	RemainingOpenings int64 `gorm:"->;-:migration" json:"remaining_openings"`
 	
 	// Relationships
-	Applications []Application `gorm:"foreignKey:JobID" json:"applications,omitempty"`
-}
\ No newline at end of file
+	Applications       []Application       `gorm:"foreignKey:JobID" json:"applications,omitempty"`
+	ScreeningQuestions []ScreeningQuestion `gorm:"foreignKey:JobID" json:"screening_questions,omitempty"`
+}
+
+// ScreeningQuestion is asked to every candidate applying to a job. Knockout
+// questions automatically reject candidates whose answer fails the rule.
+type ScreeningQuestion struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	JobID           uint      `gorm:"not null" json:"job_id"`
+	Position        int       `gorm:"not null" json:"position"`
+	Prompt          string    `gorm:"type:text;not null" json:"prompt"`
+	QuestionType    string    `gorm:"not null" json:"question_type" validate:"oneof=text yes_no multiple_choice numeric"`
+	Options         string    `gorm:"type:json" json:"options"` // JSON array, multiple_choice only
+	IsRequired      bool      `gorm:"not null" json:"is_required"`
+	IsKnockout      bool      `gorm:"not null" json:"is_knockout"`
+	AcceptedAnswers string    `gorm:"type:json" json:"accepted_answers,omitempty"` // JSON array, yes_no and multiple_choice knockout rule
+	MinValue        *float64  `json:"min_value,omitempty"`                         // numeric knockout rule
+	MaxValue        *float64  `json:"max_value,omitempty"`                         // numeric knockout rule
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// PipelineStage is one step of a job's hiring pipeline. Every stage maps onto
+// one of the four application statuses so candidates and filters keep working
+// with the coarse status while employers track finer-grained stages.
+type PipelineStage struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	JobID       uint      `gorm:"not null" json:"job_id"`
+	Key         string    `gorm:"not null" json:"key"`
+	Name        string    `gorm:"not null" json:"name"`
+	Position    int       `gorm:"not null" json:"position"`
+	Status      string    `gorm:"not null" json:"status" validate:"oneof=applied shortlisted rejected selected"`
+	AllowedNext string    `gorm:"type:json;not null" json:"allowed_next"` // JSON array of stage keys
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// JobRevision is a versioned snapshot of a job taken every time it changes
+type JobRevision struct {
+	ID              uint      `gorm:"primaryKey" json:"id"`
+	JobID           uint      `gorm:"not null" json:"job_id"`
+	Version         int       `gorm:"not null" json:"version"`
+	Action          string    `gorm:"not null" json:"action"`
+	ChangedByUserID uint      `gorm:"not null" json:"changed_by_user_id"`
+	Changes         string    `gorm:"type:json" json:"changes"`           // JSON object: field -> {old, new}
+	Snapshot        string    `gorm:"type:json;not null" json:"snapshot"` // JSON object of job fields
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// JobFieldChange describes how a single job field changed over time
+type JobFieldChange struct {
+	Field     string    `json:"field"`
+	OldValue  string    `json:"old_value"`
+	NewValue  string    `json:"new_value"`
+	ChangedAt time.Time `json:"changed_at"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+)
+
+// Notification is an in-app event shown to a single user. The optional IDs
+// point at the record the notification is about.
+type Notification struct {
+	ID             uint       `gorm:"primaryKey" json:"id"`
+	UserID         uint       `gorm:"not null;index" json:"user_id"`
+	Type           string     `gorm:"not null" json:"type" validate:"oneof=application_status_changed new_message new_applicant job_closing_soon interview_proposed interview_scheduled interview_cancelled interview_reminder offer_received offer_responded"`
+	Title          string     `gorm:"not null" json:"title"`
+	Body           string     `gorm:"type:text" json:"body"`
+	JobID          *uint      `json:"job_id,omitempty"`
+	ApplicationID  *uint      `json:"application_id,omitempty"`
+	ConversationID *uint      `json:"conversation_id,omitempty"`
+	ReadAt         *time.Time `json:"read_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
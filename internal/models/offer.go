@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+// Offer statuses
+const (
+	OfferStatusPending   = "pending"
+	OfferStatusAccepted  = "accepted"
+	OfferStatusDeclined  = "declined"
+	OfferStatusWithdrawn = "withdrawn"
+	OfferStatusExpired   = "expired"
+)
+
+// Offer is made by the employer to a selected candidate. The candidate can
+// accept or decline it until it expires.
+type Offer struct {
+	ID              uint        `gorm:"primaryKey" json:"id"`
+	ApplicationID   uint        `gorm:"not null;index" json:"application_id"`
+	Application     Application `gorm:"foreignKey:ApplicationID" json:"application,omitempty"`
+	Status          string      `gorm:"not null;default:pending" json:"status" validate:"oneof=pending accepted declined withdrawn expired"`
+	Stipend         string      `gorm:"not null" json:"stipend"`
+	StartDate       time.Time   `gorm:"not null" json:"start_date"`
+	Duration        string      `gorm:"not null" json:"duration"`
+	Message         string      `gorm:"type:text" json:"message"`
+	DocumentURL     string      `json:"document_url,omitempty"`
+	DocumentName    string      `json:"document_name,omitempty"`
+	ExpiresAt       time.Time   `gorm:"not null" json:"expires_at"`
+	RespondedAt     *time.Time  `json:"responded_at"`
+	DeclineReason   string      `gorm:"type:text" json:"decline_reason,omitempty"`
+	CreatedByUserID uint        `gorm:"not null" json:"created_by_user_id"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
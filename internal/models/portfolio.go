@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+)
+
+// Portfolio media types
+const (
+	PortfolioMediaImage = "image"
+	PortfolioMediaPDF   = "pdf"
+)
+
+// PortfolioProject is one project in a job seeker's portfolio. Projects are
+// shown in Position order.
+type PortfolioProject struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	JobSeekerID  uint      `gorm:"not null;index" json:"job_seeker_id"`
+	Title        string    `gorm:"not null" json:"title"`
+	Year         int       `json:"year,omitempty"`
+	Typology     string    `json:"typology"` // e.g. residential, institutional, interiors
+	Role         string    `json:"role"`
+	Description  string    `gorm:"type:text" json:"description"`
+	SoftwareUsed string    `gorm:"type:json" json:"software_used"` // JSON array
+	Position     int       `gorm:"not null;default:0" json:"position"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+
+	// Relationships
+	Media []PortfolioMedia `gorm:"foreignKey:ProjectID" json:"media"`
+}
+
+// PortfolioMedia is an image or PDF page of a portfolio project
+type PortfolioMedia struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	ProjectID uint      `gorm:"not null" json:"project_id"`
+	URL       string    `gorm:"not null" json:"url"`
+	MediaType string    `gorm:"not null" json:"media_type" validate:"oneof=image pdf"`
+	Caption   string    `json:"caption"`
+	Position  int       `gorm:"not null;default:0" json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName keeps the plural of "media" as it is
+func (PortfolioMedia) TableName() string {
+	return "portfolio_media"
+}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // Extension models for specific use cases
@@ -14,13 +16,92 @@ type StudentProfile struct {
 	Degree                string    `gorm:"not null" json:"degree" validate:"required"`
 	YearSemester          string    `gorm:"not null" json:"year_semester" validate:"required"`
 	SoftwareProficiency   string    `gorm:"type:json" json:"software_proficiency"`   // JSON array
-	PreviousInternships   string    `gorm:"type:json" json:"previous_internships"`   // JSON array
-	FreelanceProjects     string    `gorm:"type:json" json:"freelance_projects"`     // JSON array
 	PreferredStartMonth   string    `json:"preferred_start_month"`
 	PreferredDuration     string    `json:"preferred_duration"`
 	WillingToRelocate     bool      `gorm:"default:false" json:"willing_to_relocate"`
 	CreatedAt             time.Time `json:"created_at"`
 	UpdatedAt             time.Time `json:"updated_at"`
+	DeletedAt             gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+
+	// Relationships
+	Internships       []StudentProfileEntry `gorm:"foreignKey:StudentProfileID" json:"internships"`
+	FreelanceProjects []StudentProfileEntry `gorm:"foreignKey:StudentProfileID" json:"freelance_projects"`
+}
+
+// Student profile entry types
+const (
+	EntryTypeInternship       = "internship"
+	EntryTypeFreelanceProject = "freelance_project"
+)
+
+// StudentProfileEntry is an internship or freelance project on a student's
+// profile. Entries migrated from the old free-text lists may only have an
+// organization.
+type StudentProfileEntry struct {
+	ID               uint       `gorm:"primaryKey" json:"id"`
+	StudentProfileID uint       `gorm:"not null" json:"student_profile_id"`
+	EntryType        string     `gorm:"not null" json:"entry_type" validate:"oneof=internship freelance_project"`
+	Organization     string     `gorm:"not null" json:"organization"`
+	Role             string     `gorm:"not null" json:"role"`
+	StartDate        *time.Time `gorm:"type:date" json:"start_date"`
+	EndDate          *time.Time `gorm:"type:date" json:"end_date"` // nil while ongoing
+	Description      string     `gorm:"type:text" json:"description"`
+	Links            string     `gorm:"type:json" json:"links"` // JSON array of URLs
+	Media            string     `gorm:"type:json" json:"media"` // JSON array of image or document URLs
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// ProfessionalProfile extends job seekers of type 'professional'
+type ProfessionalProfile struct {
+	ID                    uint           `gorm:"primaryKey" json:"id"`
+	JobSeekerID           uint           `gorm:"not null" json:"job_seeker_id"`
+	JobSeeker             JobSeeker      `gorm:"foreignKey:JobSeekerID" json:"job_seeker,omitempty"`
+	CurrentTitle          string         `json:"current_title"`
+	YearsOfExperience     int            `gorm:"not null;default:0" json:"years_of_experience"`
+	CoARegistrationNumber string         `gorm:"column:coa_registration_number" json:"coa_registration_number"` // Council of Architecture
+	OtherLicenses         string         `gorm:"type:json" json:"other_licenses"`                               // JSON array
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+	DeletedAt             gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+
+	// Relationships
+	WorkHistory []WorkHistoryEntry `gorm:"foreignKey:ProfessionalProfileID" json:"work_history"`
+}
+
+// WorkHistoryEntry is one past or current position on a professional profile
+type WorkHistoryEntry struct {
+	ID                    uint       `gorm:"primaryKey" json:"id"`
+	ProfessionalProfileID uint       `gorm:"not null" json:"professional_profile_id"`
+	Organization          string     `gorm:"not null" json:"organization"`
+	Title                 string     `gorm:"not null" json:"title"`
+	StartDate             time.Time  `gorm:"type:date;not null" json:"start_date"`
+	EndDate               *time.Time `gorm:"type:date" json:"end_date"` // nil for the current position
+	Description           string     `gorm:"type:text" json:"description"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
+// Freelancer availability
+const (
+	AvailabilityAvailable   = "available"
+	AvailabilityLimited     = "limited"
+	AvailabilityUnavailable = "unavailable"
+)
+
+// FreelancerProfile extends job seekers of type 'freelancer'
+type FreelancerProfile struct {
+	ID           uint           `gorm:"primaryKey" json:"id"`
+	JobSeekerID  uint           `gorm:"not null" json:"job_seeker_id"`
+	JobSeeker    JobSeeker      `gorm:"foreignKey:JobSeekerID" json:"job_seeker,omitempty"`
+	HourlyRate   int            `json:"hourly_rate"`   // INR, 0 when not quoted
+	ProjectRate  string         `json:"project_rate"`  // free text, e.g. "₹40,000 per facade study"
+	Availability string         `gorm:"not null;default:available" json:"availability" validate:"oneof=available limited unavailable"`
+	HoursPerWeek int            `json:"hours_per_week"`
+	Services     string         `gorm:"type:json" json:"services"` // JSON array
+	Clients      string         `gorm:"type:json" json:"clients"`  // JSON array of past clients
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `gorm:"index" json:"deleted_at"`
 }
 
 type FirmProfile struct {
@@ -39,4 +120,5 @@ type FirmProfile struct {
 	ProjectImages        string    `gorm:"type:json" json:"project_images"` // JSON array
 	CreatedAt            time.Time `json:"created_at"`
 	UpdatedAt            time.Time `json:"updated_at"`
+	DeletedAt            gorm.DeletedAt `gorm:"index" json:"deleted_at"`
 }
\ No newline at end of file
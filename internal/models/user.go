@@ -1,19 +1,26 @@
 package models
 
 import (
+	"fmt"
 	"time"
 
 	"gorm.io/gorm"
 )
 
 type User struct {
-	ID           uint      `gorm:"primaryKey" json:"id"`
-	Email        string    `gorm:"uniqueIndex;not null" json:"email" validate:"required,email"`
-	PasswordHash string    `gorm:"not null" json:"-"` // Don't include in JSON responses
-	UserType     string    `gorm:"not null" json:"user_type" validate:"required,oneof=job_seeker employer"`
-	IsVerified   bool      `gorm:"default:false" json:"is_verified"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	Email         string    `gorm:"uniqueIndex;not null" json:"email" validate:"required,email"`
+	PasswordHash  string    `gorm:"not null" json:"-"`                 // Don't include in JSON responses
+	UserType      string    `gorm:"not null" json:"user_type" validate:"required,oneof=job_seeker employer"`
+	IsVerified    bool      `gorm:"default:false" json:"is_verified"`
+	Locale        string    `gorm:"not null;default:en" json:"locale"` // preferred language for emails
+	CalendarToken *string   `gorm:"uniqueIndex" json:"-"`              // secret for the interview calendar feed
+	PhotoURL      string    `json:"-"`                                 // uploaded photo; served through AvatarURL
+	PhotoThumbURL string    `json:"-"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	AvatarURL string `gorm:"-" json:"avatar_url"` // set by AfterFind
 	
 	// Relationships
 	JobSeeker *JobSeeker `gorm:"foreignKey:UserID" json:"job_seeker,omitempty"`
@@ -21,29 +28,43 @@ type User struct {
 }
 
 type JobSeeker struct {
-	ID            uint      `gorm:"primaryKey" json:"id"`
-	UserID        uint      `gorm:"uniqueIndex;not null" json:"user_id"`
-	User          User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
-	FullName      string    `gorm:"not null" json:"full_name" validate:"required"`
-	JobSeekerType string    `gorm:"not null" json:"job_seeker_type" validate:"required,oneof=student professional freelancer"`
-	CurrentCity   string    `gorm:"not null" json:"current_city" validate:"required"`
-	Phone         string    `gorm:"not null" json:"phone" validate:"required"`
-	DesiredField  string    `gorm:"not null" json:"desired_field" validate:"required"`
-	ResumeURL     string    `gorm:"not null" json:"resume_url" validate:"required"`
-	PortfolioURL  string    `json:"portfolio_url"`
-	Skills        string    `gorm:"type:json" json:"skills"` // JSON array as string
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	ID                  uint      `gorm:"primaryKey" json:"id"`
+	UserID              uint      `gorm:"uniqueIndex;not null" json:"user_id"`
+	User                User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	FullName            string    `gorm:"not null" json:"full_name" validate:"required"`
+	JobSeekerType       string    `gorm:"not null" json:"job_seeker_type" validate:"required,oneof=student professional freelancer"`
+	CurrentCity         string    `gorm:"not null" json:"current_city" validate:"required"`
+	Phone               string    `gorm:"not null" json:"phone" validate:"required"`
+	DesiredField        string    `gorm:"not null" json:"desired_field" validate:"required"`
+	ResumeURL           string    `gorm:"not null" json:"resume_url" validate:"required"`
+	PortfolioURL        string    `json:"portfolio_url"`
+	Skills              string    `gorm:"type:json" json:"skills"` // JSON array as string
+	OpenToOpportunities bool      `gorm:"not null;default:false" json:"open_to_opportunities"` // listed in employer talent search
+	TalentVisibility    string    `gorm:"not null;default:full" json:"talent_visibility" validate:"oneof=full anonymous"`
+	PortfolioShareToken *string   `gorm:"uniqueIndex" json:"-"` // secret for the public portfolio link
+	ProfileSlug         *string   `gorm:"uniqueIndex" json:"profile_slug"` // public profile at /api/profiles/:slug
+	ProfileVisibility   string    `gorm:"not null;default:private" json:"profile_visibility" validate:"oneof=private unlisted public"`
+	HidePhone           bool      `gorm:"not null;default:true" json:"hide_phone"`
+	HideCity            bool      `gorm:"not null;default:false" json:"hide_city"`
+	HideResume          bool      `gorm:"not null;default:false" json:"hide_resume"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+
+	AvatarURL string `gorm:"-" json:"avatar_url"` // set by AfterFind
 	
 	// Relationships
-	StudentProfile *StudentProfile `gorm:"foreignKey:JobSeekerID" json:"student_profile,omitempty"`
-	Applications   []Application   `gorm:"foreignKey:JobSeekerID" json:"applications,omitempty"`
+	StudentProfile      *StudentProfile      `gorm:"foreignKey:JobSeekerID" json:"student_profile,omitempty"`
+	ProfessionalProfile *ProfessionalProfile `gorm:"foreignKey:JobSeekerID" json:"professional_profile,omitempty"`
+	FreelancerProfile   *FreelancerProfile   `gorm:"foreignKey:JobSeekerID" json:"freelancer_profile,omitempty"`
+	PortfolioProjects   []PortfolioProject   `gorm:"foreignKey:JobSeekerID" json:"portfolio_projects,omitempty"`
+	Applications        []Application        `gorm:"foreignKey:JobSeekerID" json:"applications,omitempty"`
 }
 
 type Employer struct {
 	ID                 uint      `gorm:"primaryKey" json:"id"`
 	UserID             uint      `gorm:"uniqueIndex;not null" json:"user_id"`
 	User               User      `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	Slug               string    `gorm:"uniqueIndex;not null" json:"slug"`
 	CompanyName        string    `gorm:"not null" json:"company_name" validate:"required"`
 	EmployerType       string    `gorm:"not null" json:"employer_type" validate:"required,oneof=firm corporation startup"`
 	Industry           string    `gorm:"not null" json:"industry" validate:"required"`
@@ -58,6 +79,9 @@ type Employer struct {
 	IsHiring           bool      `gorm:"default:false" json:"is_hiring"`
 	CreatedAt          time.Time `json:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at"`
+
+	ActiveJobCount int64  `gorm:"->;-:migration" json:"active_job_count,omitempty"` // loaded by the company directory
+	AvatarURL      string `gorm:"-" json:"avatar_url"`                               // set by AfterFind
 	
 	// Relationships
 	FirmProfile *FirmProfile `gorm:"foreignKey:EmployerID" json:"firm_profile,omitempty"`
@@ -73,4 +97,30 @@ func (u *User) BeforeCreate(tx *gorm.DB) error {
 func (js *JobSeeker) BeforeCreate(tx *gorm.DB) error {
 	// Additional validation logic can be added here
 	return nil
-}
\ No newline at end of file
+}
+
+// AvatarPath is where a user's avatar is served: their uploaded photo or
+// employer logo, otherwise a generated initials image
+func AvatarPath(userID uint) string {
+	return fmt.Sprintf("/api/users/%d/avatar", userID)
+}
+
+func (u *User) AfterFind(tx *gorm.DB) error {
+	u.AvatarURL = AvatarPath(u.ID)
+	return nil
+}
+
+func (js *JobSeeker) AfterFind(tx *gorm.DB) error {
+	js.AvatarURL = AvatarPath(js.UserID)
+	return nil
+}
+
+func (js *JobSeeker) AfterCreate(tx *gorm.DB) error {
+	js.AvatarURL = AvatarPath(js.UserID)
+	return nil
+}
+
+func (e *Employer) AfterFind(tx *gorm.DB) error {
+	e.AvatarURL = AvatarPath(e.UserID)
+	return nil
+}
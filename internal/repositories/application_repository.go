@@ -1,19 +1,61 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 
 	"gorm.io/gorm"
 )
 
+type ApplicantFilters struct {
+	Status       string
+	Stage        string
+	SeekerType   string
+	Source       string
+	City         string
+	Skills       []string
+	College      string
+	HasPortfolio *bool
+	Tag          string
+	SortBy       string
+	SortOrder    string
+	Offset       int
+	Limit        int
+}
+
+// matchScoreSQL is the percentage of the job's required skills that appear in
+// the candidate's skills, compared case-insensitively.
+const matchScoreSQL = `CASE WHEN json_array_length(jobs.required_skills) = 0 THEN 0 ELSE
+	ROUND(100.0 * (
+		SELECT COUNT(*) FROM json_array_elements_text(jobs.required_skills) AS required(skill)
+		WHERE LOWER(required.skill) IN (
+			SELECT LOWER(own.skill) FROM json_array_elements_text(COALESCE(job_seekers.skills, '[]'::json)) AS own(skill)
+		)
+	) / json_array_length(jobs.required_skills)) END`
+
+const ratingSQL = `COALESCE((SELECT rating FROM application_ratings WHERE application_ratings.application_id = applications.id
+	ORDER BY application_ratings.updated_at DESC LIMIT 1), 0) AS rating`
+
 type ApplicationRepository interface {
 	Create(application *models.Application) error
+	CreateWithHistory(application *models.Application, change *models.ApplicationStatusChange) error
 	GetByID(id uint) (*models.Application, error)
+	GetByIDs(ids []uint) ([]models.Application, error)
 	GetByJobSeekerID(jobSeekerID uint) ([]models.Application, error)
 	GetByJobID(jobID uint) ([]models.Application, error)
+	SearchByJobID(jobID uint, filters ApplicantFilters) ([]models.Application, int64, error)
 	GetByJobAndJobSeeker(jobID, jobSeekerID uint) (*models.Application, error)
 	Update(application *models.Application) error
+	UpdateStatusWithHistory(application *models.Application, change *models.ApplicationStatusChange) error
+	UpdateStatusesWithHistory(applications []*models.Application, changes []*models.ApplicationStatusChange) error
+	GetStatusHistory(applicationID uint) ([]models.ApplicationStatusChange, error)
 	Delete(id uint) error
+	WithdrawWithHistory(application *models.Application, change *models.ApplicationStatusChange) error
+	GetDeletedByID(id uint) (*models.Application, error)
+	GetDeletedByJobSeekerID(jobSeekerID uint) ([]models.Application, error)
+	RestoreWithHistory(application *models.Application, change *models.ApplicationStatusChange) error
+	PurgeDeletedBefore(cutoff time.Time) (int64, error)
 	CountByJobID(jobID uint) (int64, error)
 	CountByJobSeekerID(jobSeekerID uint) (int64, error)
 }
@@ -30,18 +72,41 @@ func (r *applicationRepository) Create(application *models.Application) error {
 	return r.db.Create(application).Error
 }
 
+func (r *applicationRepository) CreateWithHistory(application *models.Application, change *models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(application).Error; err != nil {
+			return err
+		}
+		change.ApplicationID = application.ID
+		return tx.Create(change).Error
+	})
+}
+
 func (r *applicationRepository) GetByID(id uint) (*models.Application, error) {
 	var application models.Application
-	err := r.db.Preload("Job").Preload("JobSeeker").First(&application, id).Error
+	err := r.db.Preload("Job", withDeleted).Preload("Job.Employer").Preload("JobSeeker").Preload("Answers.Question").
+		Preload("PortfolioProjects", orderByPortfolioPosition).
+		Preload("PortfolioProjects.Media", orderByPortfolioPosition).
+		First(&application, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &application, nil
 }
 
+func (r *applicationRepository) GetByIDs(ids []uint) ([]models.Application, error) {
+	var applications []models.Application
+	if len(ids) == 0 {
+		return applications, nil
+	}
+
+	err := r.db.Preload("Job", withDeleted).Preload("JobSeeker").Where("id IN ?", ids).Find(&applications).Error
+	return applications, err
+}
+
 func (r *applicationRepository) GetByJobSeekerID(jobSeekerID uint) ([]models.Application, error) {
 	var applications []models.Application
-	err := r.db.Preload("Job").Preload("Job.Employer").
+	err := r.db.Preload("Job", withDeleted).Preload("Job.Employer").
 		Where("job_seeker_id = ?", jobSeekerID).
 		Order("applied_at DESC").
 		Find(&applications).Error
@@ -50,13 +115,89 @@ func (r *applicationRepository) GetByJobSeekerID(jobSeekerID uint) ([]models.App
 
 func (r *applicationRepository) GetByJobID(jobID uint) ([]models.Application, error) {
 	var applications []models.Application
-	err := r.db.Preload("JobSeeker").
+	err := r.db.Preload("JobSeeker").Preload("Answers.Question").
 		Where("job_id = ?", jobID).
 		Order("applied_at DESC").
 		Find(&applications).Error
 	return applications, err
 }
 
+func (r *applicationRepository) SearchByJobID(jobID uint, filters ApplicantFilters) ([]models.Application, int64, error) {
+	query := r.db.Model(&models.Application{}).
+		Joins("JOIN jobs ON jobs.id = applications.job_id").
+		Joins("JOIN job_seekers ON job_seekers.id = applications.job_seeker_id").
+		Where("applications.job_id = ?", jobID)
+
+	if filters.Status != "" {
+		query = query.Where("applications.status = ?", filters.Status)
+	}
+	if filters.Stage != "" {
+		query = query.Where("applications.stage = ?", filters.Stage)
+	}
+	if filters.SeekerType != "" {
+		query = query.Where("job_seekers.job_seeker_type = ?", filters.SeekerType)
+	}
+	if filters.Source != "" {
+		query = query.Where("applications.source = ?", filters.Source)
+	}
+	if filters.City != "" {
+		query = query.Where("job_seekers.current_city ILIKE ?", "%"+filters.City+"%")
+	}
+	for _, skill := range filters.Skills {
+		query = query.Where("EXISTS (SELECT 1 FROM json_array_elements_text(COALESCE(job_seekers.skills, '[]'::json)) AS own(skill) WHERE LOWER(own.skill) = LOWER(?))", skill)
+	}
+	if filters.College != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM student_profiles WHERE student_profiles.job_seeker_id = job_seekers.id AND student_profiles.deleted_at IS NULL AND student_profiles.college_name ILIKE ?)", "%"+filters.College+"%")
+	}
+	if filters.HasPortfolio != nil {
+		// A portfolio PDF or portfolio projects both count, as they do for eligibility
+		hasPortfolio := "(COALESCE(job_seekers.portfolio_url, '') <> '' OR " +
+			"EXISTS (SELECT 1 FROM portfolio_projects WHERE portfolio_projects.job_seeker_id = job_seekers.id))"
+		if *filters.HasPortfolio {
+			query = query.Where(hasPortfolio)
+		} else {
+			query = query.Where("NOT " + hasPortfolio)
+		}
+	}
+
+	if filters.Tag != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM application_tags WHERE application_tags.application_id = applications.id AND application_tags.tag = ?)", filters.Tag)
+	}
+
+	base := query.Session(&gorm.Session{})
+
+	var total int64
+	if err := base.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	direction := "DESC"
+	if filters.SortOrder == "asc" {
+		direction = "ASC"
+	}
+	orderBy := "applications.applied_at " + direction
+	switch filters.SortBy {
+	case "match_score":
+		orderBy = "match_score " + direction + ", applications.applied_at DESC"
+	case "rating":
+		orderBy = "rating " + direction + ", applications.applied_at DESC"
+	}
+
+	var applications []models.Application
+	err := base.Select("applications.*, "+matchScoreSQL+" AS match_score, "+ratingSQL).
+		Preload("JobSeeker").
+		Preload("Answers.Question").
+		Preload("Tags").
+		Preload("PortfolioProjects", orderByPortfolioPosition).
+		Preload("PortfolioProjects.Media", orderByPortfolioPosition).
+		Order(orderBy).
+		Order("applications.id").
+		Offset(filters.Offset).
+		Limit(filters.Limit).
+		Find(&applications).Error
+	return applications, total, err
+}
+
 func (r *applicationRepository) GetByJobAndJobSeeker(jobID, jobSeekerID uint) (*models.Application, error) {
 	var application models.Application
 	err := r.db.Where("job_id = ? AND job_seeker_id = ?", jobID, jobSeekerID).First(&application).Error
@@ -67,13 +208,114 @@ func (r *applicationRepository) GetByJobAndJobSeeker(jobID, jobSeekerID uint) (*
 }
 
 func (r *applicationRepository) Update(application *models.Application) error {
-	return r.db.Save(application).Error
+	return r.db.Omit("PortfolioProjects").Save(application).Error
+}
+
+// UpdateStatusWithHistory moves the application to its new stage and status
+// and records the change in a single transaction.
+func (r *applicationRepository) UpdateStatusWithHistory(application *models.Application, change *models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return updateStatusWithHistory(tx, application, change)
+	})
+}
+
+// UpdateStatusesWithHistory applies a batch of status changes atomically;
+// changes[i] describes applications[i].
+func (r *applicationRepository) UpdateStatusesWithHistory(applications []*models.Application, changes []*models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, application := range applications {
+			if err := updateStatusWithHistory(tx, application, changes[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func updateStatusWithHistory(tx *gorm.DB, application *models.Application, change *models.ApplicationStatusChange) error {
+	err := tx.Model(&models.Application{}).
+		Where("id = ?", application.ID).
+		Updates(map[string]interface{}{
+			"status":     application.Status,
+			"stage":      application.Stage,
+			"updated_at": application.UpdatedAt,
+		}).Error
+	if err != nil {
+		return err
+	}
+	change.ApplicationID = application.ID
+	return tx.Create(change).Error
+}
+
+func (r *applicationRepository) GetStatusHistory(applicationID uint) ([]models.ApplicationStatusChange, error) {
+	var history []models.ApplicationStatusChange
+	err := r.db.Where("application_id = ?", applicationID).Order("created_at ASC, id ASC").Find(&history).Error
+	return history, err
 }
 
 func (r *applicationRepository) Delete(id uint) error {
 	return r.db.Delete(&models.Application{}, id).Error
 }
 
+// WithdrawWithHistory soft-deletes the application and records the
+// withdrawal in a single transaction.
+func (r *applicationRepository) WithdrawWithHistory(application *models.Application, change *models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.Application{}, application.ID).Error; err != nil {
+			return err
+		}
+		change.ApplicationID = application.ID
+		return tx.Create(change).Error
+	})
+}
+
+func (r *applicationRepository) GetDeletedByID(id uint) (*models.Application, error) {
+	var application models.Application
+	err := r.db.Unscoped().Preload("Job", withDeleted).
+		Where("deleted_at IS NOT NULL").
+		First(&application, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &application, nil
+}
+
+func (r *applicationRepository) GetDeletedByJobSeekerID(jobSeekerID uint) ([]models.Application, error) {
+	var applications []models.Application
+	err := r.db.Unscoped().Preload("Job", withDeleted).Preload("Job.Employer").
+		Where("job_seeker_id = ? AND deleted_at IS NOT NULL", jobSeekerID).
+		Order("deleted_at DESC").
+		Find(&applications).Error
+	return applications, err
+}
+
+// RestoreWithHistory reinstates a withdrawn application and records the
+// change in a single transaction.
+func (r *applicationRepository) RestoreWithHistory(application *models.Application, change *models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Application{}).
+			Where("id = ?", application.ID).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+		return updateStatusWithHistory(tx, application, change)
+	})
+}
+
+func (r *applicationRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Delete(&models.Application{})
+	return result.RowsAffected, result.Error
+}
+
+// withDeleted lets preloads include soft-deleted rows, e.g. a job that was
+// removed after the candidate applied.
+func withDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
 func (r *applicationRepository) CountByJobID(jobID uint) (int64, error) {
 	var count int64
 	err := r.db.Model(&models.Application{}).Where("job_id = ?", jobID).Count(&count).Error
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ApplicationReviewRepository stores the employer's private notes, rating and
+// tags on applications.
+type ApplicationReviewRepository interface {
+	CreateNote(note *models.ApplicationNote) error
+	GetNoteByID(id uint) (*models.ApplicationNote, error)
+	UpdateNote(note *models.ApplicationNote) error
+	DeleteNote(id uint) error
+	GetNotesByApplicationID(applicationID uint) ([]models.ApplicationNote, error)
+	UpsertRating(rating *models.ApplicationRating) error
+	GetRating(applicationID uint) (int, error)
+	ReplaceTags(applicationID uint, tags []models.ApplicationTag) error
+	GetTagsByApplicationID(applicationID uint) ([]models.ApplicationTag, error)
+}
+
+type applicationReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewApplicationReviewRepository(db *gorm.DB) ApplicationReviewRepository {
+	return &applicationReviewRepository{db: db}
+}
+
+func (r *applicationReviewRepository) CreateNote(note *models.ApplicationNote) error {
+	return r.db.Create(note).Error
+}
+
+func (r *applicationReviewRepository) GetNoteByID(id uint) (*models.ApplicationNote, error) {
+	var note models.ApplicationNote
+	err := r.db.First(&note, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &note, nil
+}
+
+func (r *applicationReviewRepository) UpdateNote(note *models.ApplicationNote) error {
+	return r.db.Save(note).Error
+}
+
+func (r *applicationReviewRepository) DeleteNote(id uint) error {
+	return r.db.Delete(&models.ApplicationNote{}, id).Error
+}
+
+func (r *applicationReviewRepository) GetNotesByApplicationID(applicationID uint) ([]models.ApplicationNote, error) {
+	var notes []models.ApplicationNote
+	err := r.db.Where("application_id = ?", applicationID).Order("created_at DESC").Find(&notes).Error
+	return notes, err
+}
+
+// UpsertRating keeps a single rating per rater, replacing any earlier one
+func (r *applicationReviewRepository) UpsertRating(rating *models.ApplicationRating) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "application_id"}, {Name: "rater_user_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"rating":     rating.Rating,
+			"updated_at": time.Now(),
+		}),
+	}).Create(rating).Error
+}
+
+// GetRating returns the application's latest rating, or 0 when it hasn't
+// been rated
+func (r *applicationReviewRepository) GetRating(applicationID uint) (int, error) {
+	var ratings []models.ApplicationRating
+	err := r.db.Where("application_id = ?", applicationID).Order("updated_at DESC").Limit(1).Find(&ratings).Error
+	if err != nil || len(ratings) == 0 {
+		return 0, err
+	}
+	return ratings[0].Rating, nil
+}
+
+func (r *applicationReviewRepository) ReplaceTags(applicationID uint, tags []models.ApplicationTag) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("application_id = ?", applicationID).Delete(&models.ApplicationTag{}).Error; err != nil {
+			return err
+		}
+		if len(tags) == 0 {
+			return nil
+		}
+		for i := range tags {
+			tags[i].ApplicationID = applicationID
+		}
+		return tx.Create(&tags).Error
+	})
+}
+
+func (r *applicationReviewRepository) GetTagsByApplicationID(applicationID uint) ([]models.ApplicationTag, error) {
+	var tags []models.ApplicationTag
+	err := r.db.Where("application_id = ?", applicationID).Order("tag ASC").Find(&tags).Error
+	return tags, err
+}
@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// participantSQL limits conversations to those where the user is the
+// applicant or the employer owning the job.
+const participantSQL = `conversations.application_id IN (
+	SELECT applications.id FROM applications
+	JOIN job_seekers ON job_seekers.id = applications.job_seeker_id
+	JOIN jobs ON jobs.id = applications.job_id
+	JOIN employers ON employers.id = jobs.employer_id
+	WHERE job_seekers.user_id = @user OR employers.user_id = @user
+)`
+
+type ConversationRepository interface {
+	Create(conversation *models.Conversation) error
+	GetByID(id uint) (*models.Conversation, error)
+	GetByApplicationID(applicationID uint) (*models.Conversation, error)
+	GetForUser(userID uint) ([]models.Conversation, error)
+	GetMessages(conversationID uint) ([]models.Message, error)
+	CreateMessage(message *models.Message) error
+	MarkRead(conversationID uint, readerUserID uint) error
+	CountUnread(userID uint) (int64, error)
+}
+
+type conversationRepository struct {
+	db *gorm.DB
+}
+
+func NewConversationRepository(db *gorm.DB) ConversationRepository {
+	return &conversationRepository{db: db}
+}
+
+func (r *conversationRepository) Create(conversation *models.Conversation) error {
+	return r.db.Create(conversation).Error
+}
+
+func (r *conversationRepository) GetByID(id uint) (*models.Conversation, error) {
+	var conversation models.Conversation
+	err := r.db.Preload("Application", withDeleted).
+		Preload("Application.Job", withDeleted).
+		Preload("Application.Job.Employer").
+		Preload("Application.JobSeeker").
+		First(&conversation, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+func (r *conversationRepository) GetByApplicationID(applicationID uint) (*models.Conversation, error) {
+	var conversation models.Conversation
+	err := r.db.Where("application_id = ?", applicationID).First(&conversation).Error
+	if err != nil {
+		return nil, err
+	}
+	return &conversation, nil
+}
+
+func (r *conversationRepository) GetForUser(userID uint) ([]models.Conversation, error) {
+	var conversations []models.Conversation
+	err := r.db.
+		Select(`conversations.*, (
+			SELECT COUNT(*) FROM messages
+			WHERE messages.conversation_id = conversations.id
+			AND messages.sender_user_id <> @user AND messages.read_at IS NULL
+		) AS unread_count`, map[string]interface{}{"user": userID}).
+		Where(participantSQL, map[string]interface{}{"user": userID}).
+		Preload("Application", withDeleted).
+		Preload("Application.Job", withDeleted).
+		Preload("Application.Job.Employer").
+		Preload("Application.JobSeeker").
+		Order("COALESCE(conversations.last_message_at, conversations.created_at) DESC").
+		Find(&conversations).Error
+	return conversations, err
+}
+
+func (r *conversationRepository) GetMessages(conversationID uint) ([]models.Message, error) {
+	var messages []models.Message
+	err := r.db.Where("conversation_id = ?", conversationID).Order("created_at ASC, id ASC").Find(&messages).Error
+	return messages, err
+}
+
+// CreateMessage stores the message and bumps the thread's last activity
+func (r *conversationRepository) CreateMessage(message *models.Message) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(message).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Conversation{}).
+			Where("id = ?", message.ConversationID).
+			Update("last_message_at", message.CreatedAt).Error
+	})
+}
+
+// MarkRead sets the read receipt on every message the reader hasn't seen yet
+func (r *conversationRepository) MarkRead(conversationID uint, readerUserID uint) error {
+	return r.db.Model(&models.Message{}).
+		Where("conversation_id = ? AND sender_user_id <> ? AND read_at IS NULL", conversationID, readerUserID).
+		Update("read_at", time.Now()).Error
+}
+
+func (r *conversationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Message{}).
+		Joins("JOIN conversations ON conversations.id = messages.conversation_id").
+		Where(participantSQL, map[string]interface{}{"user": userID}).
+		Where("messages.sender_user_id <> ? AND messages.read_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type EmailOutboxRepository interface {
+	Create(email *models.OutboxEmail) error
+	GetDue(limit int) ([]models.OutboxEmail, error)
+	Update(email *models.OutboxEmail) error
+}
+
+type emailOutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailOutboxRepository(db *gorm.DB) EmailOutboxRepository {
+	return &emailOutboxRepository{db: db}
+}
+
+func (r *emailOutboxRepository) Create(email *models.OutboxEmail) error {
+	return r.db.Create(email).Error
+}
+
+// GetDue returns pending emails whose next attempt is due, oldest first
+func (r *emailOutboxRepository) GetDue(limit int) ([]models.OutboxEmail, error) {
+	var emails []models.OutboxEmail
+	err := r.db.Where("status = ? AND next_attempt_at <= ?", models.EmailStatusPending, time.Now()).
+		Order("next_attempt_at ASC, id ASC").
+		Limit(limit).
+		Find(&emails).Error
+	return emails, err
+}
+
+func (r *emailOutboxRepository) Update(email *models.OutboxEmail) error {
+	return r.db.Save(email).Error
+}
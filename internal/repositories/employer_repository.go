@@ -6,12 +6,34 @@ import (
 	"gorm.io/gorm"
 )
 
+// activeJobCountSQL counts the employer's published, open jobs
+const activeJobCountSQL = `(SELECT COUNT(*) FROM jobs
+	WHERE jobs.employer_id = employers.id AND jobs.is_active = true AND jobs.is_draft = false AND jobs.deleted_at IS NULL)`
+
+type CompanyFilters struct {
+	Query               string
+	EmployerType        string
+	Industry            string
+	City                string
+	State               string
+	PrimaryDiscipline   string
+	SecondaryDiscipline string
+	FirmSize            string
+	IsHiring            *bool
+	Offset              int
+	Limit               int
+}
+
 type EmployerRepository interface {
 	Create(employer *models.Employer) error
 	GetByUserID(userID uint) (*models.Employer, error)
 	GetByID(id uint) (*models.Employer, error)
 	Update(employer *models.Employer) error
 	GetWithFirmProfile(userID uint) (*models.Employer, error)
+	GetPublicByID(id uint) (*models.Employer, error)
+	GetBySlug(slug string) (*models.Employer, error)
+	SlugExists(slug string) (bool, error)
+	SearchCompanies(filters CompanyFilters) ([]models.Employer, int64, error)
 }
 
 type employerRepository struct {
@@ -55,4 +77,82 @@ func (r *employerRepository) GetWithFirmProfile(userID uint) (*models.Employer,
 		return nil, err
 	}
 	return &employer, nil
-}
\ No newline at end of file
+}
+
+func (r *employerRepository) GetPublicByID(id uint) (*models.Employer, error) {
+	var employer models.Employer
+	err := r.db.Preload("FirmProfile").First(&employer, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &employer, nil
+}
+
+func (r *employerRepository) GetBySlug(slug string) (*models.Employer, error) {
+	var employer models.Employer
+	err := r.db.Preload("FirmProfile").Where("slug = ?", slug).First(&employer).Error
+	if err != nil {
+		return nil, err
+	}
+	return &employer, nil
+}
+
+func (r *employerRepository) SlugExists(slug string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Employer{}).Where("slug = ?", slug).Count(&count).Error
+	return count > 0, err
+}
+
+// SearchCompanies lists employers for the public directory, most active
+// hiring first, along with the total number of matches
+func (r *employerRepository) SearchCompanies(filters CompanyFilters) ([]models.Employer, int64, error) {
+	query := r.db.Model(&models.Employer{}).
+		Joins("LEFT JOIN firm_profiles ON firm_profiles.employer_id = employers.id AND firm_profiles.deleted_at IS NULL")
+
+	if filters.Query != "" {
+		query = query.Where("employers.company_name ILIKE ?", "%"+filters.Query+"%")
+	}
+	if filters.EmployerType != "" {
+		query = query.Where("employers.employer_type = ?", filters.EmployerType)
+	}
+	if filters.Industry != "" {
+		query = query.Where("employers.industry ILIKE ?", filters.Industry)
+	}
+	if filters.City != "" {
+		query = query.Where("employers.city ILIKE ?", "%"+filters.City+"%")
+	}
+	if filters.State != "" {
+		query = query.Where("employers.state ILIKE ?", filters.State)
+	}
+	if filters.PrimaryDiscipline != "" {
+		query = query.Where("firm_profiles.primary_discipline ILIKE ?", filters.PrimaryDiscipline)
+	}
+	if filters.SecondaryDiscipline != "" {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM json_array_elements_text(COALESCE(firm_profiles.secondary_disciplines, '[]'::json)) AS discipline(name)
+			WHERE LOWER(discipline.name) = LOWER(?)
+		)`, filters.SecondaryDiscipline)
+	}
+	if filters.FirmSize != "" {
+		query = query.Where("firm_profiles.firm_size = ?", filters.FirmSize)
+	}
+	if filters.IsHiring != nil {
+		query = query.Where("employers.is_hiring = ?", *filters.IsHiring)
+	}
+
+	base := query.Session(&gorm.Session{})
+
+	var total int64
+	if err := base.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var employers []models.Employer
+	err := base.Select("employers.*, " + activeJobCountSQL + " AS active_job_count").
+		Preload("FirmProfile").
+		Order("active_job_count DESC, employers.company_name ASC, employers.id").
+		Offset(filters.Offset).
+		Limit(filters.Limit).
+		Find(&employers).Error
+	return employers, total, err
+}
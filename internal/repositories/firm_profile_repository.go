@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 
 	"gorm.io/gorm"
@@ -12,6 +14,9 @@ type FirmProfileRepository interface {
 	Update(profile *models.FirmProfile) error
 	Delete(employerID uint) error
 	GetByID(id uint) (*models.FirmProfile, error)
+	GetDeletedByEmployerID(employerID uint) (*models.FirmProfile, error)
+	Restore(id uint) error
+	PurgeDeletedBefore(cutoff time.Time) (int64, error)
 }
 
 type firmProfileRepository struct {
@@ -50,4 +55,29 @@ func (r *firmProfileRepository) GetByID(id uint) (*models.FirmProfile, error) {
 		return nil, err
 	}
 	return &profile, nil
+}
+
+func (r *firmProfileRepository) GetDeletedByEmployerID(employerID uint) (*models.FirmProfile, error) {
+	var profile models.FirmProfile
+	err := r.db.Unscoped().
+		Where("employer_id = ? AND deleted_at IS NOT NULL", employerID).
+		Order("deleted_at DESC").
+		First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *firmProfileRepository) Restore(id uint) error {
+	return r.db.Unscoped().Model(&models.FirmProfile{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error
+}
+
+func (r *firmProfileRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Delete(&models.FirmProfile{})
+	return result.RowsAffected, result.Error
 }
\ No newline at end of file
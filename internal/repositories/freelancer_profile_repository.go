@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type FreelancerProfileRepository interface {
+	Create(profile *models.FreelancerProfile) error
+	GetByJobSeekerID(jobSeekerID uint) (*models.FreelancerProfile, error)
+	Update(profile *models.FreelancerProfile) error
+	Delete(jobSeekerID uint) error
+	GetDeletedByJobSeekerID(jobSeekerID uint) (*models.FreelancerProfile, error)
+	Restore(id uint) error
+	PurgeDeletedBefore(cutoff time.Time) (int64, error)
+}
+
+type freelancerProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewFreelancerProfileRepository(db *gorm.DB) FreelancerProfileRepository {
+	return &freelancerProfileRepository{db: db}
+}
+
+func (r *freelancerProfileRepository) Create(profile *models.FreelancerProfile) error {
+	return r.db.Omit("JobSeeker").Create(profile).Error
+}
+
+func (r *freelancerProfileRepository) GetByJobSeekerID(jobSeekerID uint) (*models.FreelancerProfile, error) {
+	var profile models.FreelancerProfile
+	err := r.db.Preload("JobSeeker").Where("job_seeker_id = ?", jobSeekerID).First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *freelancerProfileRepository) Update(profile *models.FreelancerProfile) error {
+	return r.db.Omit("JobSeeker").Save(profile).Error
+}
+
+func (r *freelancerProfileRepository) Delete(jobSeekerID uint) error {
+	return r.db.Where("job_seeker_id = ?", jobSeekerID).Delete(&models.FreelancerProfile{}).Error
+}
+
+func (r *freelancerProfileRepository) GetDeletedByJobSeekerID(jobSeekerID uint) (*models.FreelancerProfile, error) {
+	var profile models.FreelancerProfile
+	err := r.db.Unscoped().
+		Where("job_seeker_id = ? AND deleted_at IS NOT NULL", jobSeekerID).
+		Order("deleted_at DESC").
+		First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *freelancerProfileRepository) Restore(id uint) error {
+	return r.db.Unscoped().Model(&models.FreelancerProfile{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error
+}
+
+func (r *freelancerProfileRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Delete(&models.FreelancerProfile{})
+	return result.RowsAffected, result.Error
+}
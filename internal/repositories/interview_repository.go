@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// interviewParticipantSQL limits interviews to those where the user is the
+// candidate or the employer owning the job.
+const interviewParticipantSQL = `interviews.application_id IN (
+	SELECT applications.id FROM applications
+	JOIN job_seekers ON job_seekers.id = applications.job_seeker_id
+	JOIN jobs ON jobs.id = applications.job_id
+	JOIN employers ON employers.id = jobs.employer_id
+	WHERE job_seekers.user_id = @user OR employers.user_id = @user
+)`
+
+type InterviewRepository interface {
+	Create(interview *models.Interview) error
+	GetByID(id uint) (*models.Interview, error)
+	GetByApplicationID(applicationID uint) ([]models.Interview, error)
+	GetForUser(userID uint) ([]models.Interview, error)
+	Update(interview *models.Interview) error
+	ReplaceSlots(interview *models.Interview, slots []models.InterviewSlot) error
+	GetDueReminders(before time.Time) ([]models.Interview, error)
+	MarkReminderSent(id uint) error
+	CancelOpenForApplications(applicationIDs []uint, reason string, cancelledByUserID uint) error
+}
+
+type interviewRepository struct {
+	db *gorm.DB
+}
+
+func NewInterviewRepository(db *gorm.DB) InterviewRepository {
+	return &interviewRepository{db: db}
+}
+
+func orderByStart(db *gorm.DB) *gorm.DB {
+	return db.Order("starts_at ASC")
+}
+
+// withInterviewDetails loads everything needed to authorize and describe an interview
+func withInterviewDetails(db *gorm.DB) *gorm.DB {
+	return db.Preload("Slots", orderByStart).
+		Preload("Application", withDeleted).
+		Preload("Application.Job", withDeleted).
+		Preload("Application.Job.Employer").
+		Preload("Application.JobSeeker")
+}
+
+func (r *interviewRepository) Create(interview *models.Interview) error {
+	return r.db.Omit("Application").Create(interview).Error
+}
+
+func (r *interviewRepository) GetByID(id uint) (*models.Interview, error) {
+	var interview models.Interview
+	err := r.db.Scopes(withInterviewDetails).First(&interview, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &interview, nil
+}
+
+func (r *interviewRepository) GetByApplicationID(applicationID uint) ([]models.Interview, error) {
+	var interviews []models.Interview
+	err := r.db.Scopes(withInterviewDetails).
+		Where("application_id = ?", applicationID).
+		Order("created_at DESC").
+		Find(&interviews).Error
+	return interviews, err
+}
+
+func (r *interviewRepository) GetForUser(userID uint) ([]models.Interview, error) {
+	var interviews []models.Interview
+	err := r.db.Scopes(withInterviewDetails).
+		Where(interviewParticipantSQL, map[string]interface{}{"user": userID}).
+		Order("COALESCE(interviews.scheduled_at, interviews.created_at) DESC").
+		Find(&interviews).Error
+	return interviews, err
+}
+
+func (r *interviewRepository) Update(interview *models.Interview) error {
+	return r.db.Omit("Slots", "Application").Save(interview).Error
+}
+
+// ReplaceSlots swaps the proposed slots and saves the interview in one go,
+// used when the employer reschedules.
+func (r *interviewRepository) ReplaceSlots(interview *models.Interview, slots []models.InterviewSlot) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("interview_id = ?", interview.ID).Delete(&models.InterviewSlot{}).Error; err != nil {
+			return err
+		}
+		for i := range slots {
+			slots[i].InterviewID = interview.ID
+		}
+		if err := tx.Create(&slots).Error; err != nil {
+			return err
+		}
+		if err := tx.Omit("Slots", "Application").Save(interview).Error; err != nil {
+			return err
+		}
+		interview.Slots = slots
+		return nil
+	})
+}
+
+// GetDueReminders returns scheduled interviews starting before the given
+// time whose participants haven't been reminded yet.
+func (r *interviewRepository) GetDueReminders(before time.Time) ([]models.Interview, error) {
+	var interviews []models.Interview
+	err := r.db.Scopes(withInterviewDetails).
+		Joins("JOIN applications ON applications.id = interviews.application_id").
+		Where("interviews.status = ? AND interviews.reminder_sent_at IS NULL", models.InterviewStatusScheduled).
+		Where("interviews.scheduled_at > ? AND interviews.scheduled_at <= ?", time.Now(), before).
+		// Nobody is reminded about interviews for rejected or withdrawn applications
+		Where("applications.status <> ? AND applications.deleted_at IS NULL", "rejected").
+		Find(&interviews).Error
+	return interviews, err
+}
+
+func (r *interviewRepository) MarkReminderSent(id uint) error {
+	return r.db.Model(&models.Interview{}).Where("id = ?", id).UpdateColumn("reminder_sent_at", time.Now()).Error
+}
+
+// CancelOpenForApplications cancels the proposed and scheduled interviews of
+// applications that were rejected or withdrawn. Sequence is bumped so
+// subscribed calendars drop the event.
+func (r *interviewRepository) CancelOpenForApplications(applicationIDs []uint, reason string, cancelledByUserID uint) error {
+	return r.db.Model(&models.Interview{}).
+		Where("application_id IN ? AND status IN ?", applicationIDs,
+			[]string{models.InterviewStatusProposed, models.InterviewStatusScheduled}).
+		Updates(map[string]interface{}{
+			"status":               models.InterviewStatusCancelled,
+			"cancel_reason":        reason,
+			"cancelled_by_user_id": cancelledByUserID,
+			"sequence":             gorm.Expr("sequence + 1"),
+			"updated_at":           time.Now(),
+		}).Error
+}
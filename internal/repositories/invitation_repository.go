@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type InvitationRepository interface {
+	Create(invitation *models.Invitation) error
+	GetByID(id uint) (*models.Invitation, error)
+	GetByJobSeekerID(jobSeekerID uint, status string) ([]models.Invitation, error)
+	GetByEmployerID(employerID uint, jobID uint) ([]models.Invitation, error)
+	HasInvitation(jobID uint, jobSeekerID uint, statuses []string) (bool, error)
+	CountSentSince(employerID uint, since time.Time) (int64, error)
+	Update(invitation *models.Invitation) error
+	ExpireOverdue() error
+}
+
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+func NewInvitationRepository(db *gorm.DB) InvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+func (r *invitationRepository) Create(invitation *models.Invitation) error {
+	return r.db.Omit("Job", "JobSeeker").Create(invitation).Error
+}
+
+func (r *invitationRepository) GetByID(id uint) (*models.Invitation, error) {
+	var invitation models.Invitation
+	err := r.db.Preload("Job", withDeleted).
+		Preload("Job.Employer").
+		Preload("Job.ScreeningQuestions", orderByPosition).
+		Preload("JobSeeker").
+		First(&invitation, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// GetByJobSeekerID returns the candidate's inbox, optionally limited to one status
+func (r *invitationRepository) GetByJobSeekerID(jobSeekerID uint, status string) ([]models.Invitation, error) {
+	query := r.db.Where("job_seeker_id = ?", jobSeekerID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var invitations []models.Invitation
+	err := query.Preload("Job", withDeleted).
+		Preload("Job.Employer").
+		Order("created_at DESC").
+		Find(&invitations).Error
+	return invitations, err
+}
+
+// GetByEmployerID returns invitations the employer sent, optionally for one job
+func (r *invitationRepository) GetByEmployerID(employerID uint, jobID uint) ([]models.Invitation, error) {
+	query := r.db.Where("employer_id = ?", employerID)
+	if jobID > 0 {
+		query = query.Where("job_id = ?", jobID)
+	}
+
+	var invitations []models.Invitation
+	err := query.Preload("Job", withDeleted).
+		Order("created_at DESC").
+		Find(&invitations).Error
+	return invitations, err
+}
+
+func (r *invitationRepository) HasInvitation(jobID uint, jobSeekerID uint, statuses []string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Invitation{}).
+		Where("job_id = ? AND job_seeker_id = ? AND status IN ?", jobID, jobSeekerID, statuses).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *invitationRepository) CountSentSince(employerID uint, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Invitation{}).
+		Where("employer_id = ? AND created_at >= ?", employerID, since).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *invitationRepository) Update(invitation *models.Invitation) error {
+	return r.db.Omit("Job", "JobSeeker").Save(invitation).Error
+}
+
+// ExpireOverdue marks pending invitations past their expiry as expired
+func (r *invitationRepository) ExpireOverdue() error {
+	return r.db.Model(&models.Invitation{}).
+		Where("status = ? AND expires_at <= ?", models.InvitationStatusPending, time.Now()).
+		Updates(map[string]interface{}{"status": models.InvitationStatusExpired, "updated_at": time.Now()}).Error
+}
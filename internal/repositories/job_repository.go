@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 
 	"gorm.io/gorm"
@@ -15,17 +17,39 @@ type JobFilters struct {
 	EmploymentMode  string 
 	IsPaid          *bool  
 	IsActive        *bool  
+	IsDraft         *bool  
 	OrderBy         string 
 	OrderDirection  string 
 	Limit           int    
 }
 
+// filledOpeningsSQL counts the job's selected candidates, each of whom fills
+// one of its openings. A candidate whose latest offer was declined, expired or
+// withdrawn frees their opening again.
+const filledOpeningsSQL = `(SELECT COUNT(*) FROM applications
+	WHERE applications.job_id = jobs.id AND applications.status = 'selected' AND applications.deleted_at IS NULL
+	AND COALESCE((SELECT offers.status FROM offers WHERE offers.application_id = applications.id
+		ORDER BY offers.id DESC LIMIT 1), 'pending') NOT IN ('declined', 'expired', 'withdrawn'))`
+
 type JobRepository interface {
 	Create(job *models.Job) error
+	CreateWithRevision(job *models.Job, revision *models.JobRevision) error
 	GetByID(id uint) (*models.Job, error)
 	GetByEmployerID(employerID uint) ([]models.Job, error)
 	Update(job *models.Job) error
+	UpdateWithRevision(job *models.Job, revision *models.JobRevision) error
+	ReplaceScreeningQuestions(job *models.Job, questions []models.ScreeningQuestion, revision *models.JobRevision) error
+	HasApplications(jobID uint) (bool, error)
 	Delete(id uint) error
+	DeleteWithRevision(job *models.Job, revision *models.JobRevision) error
+	GetDeletedByID(id uint) (*models.Job, error)
+	GetDeletedByEmployerID(employerID uint) ([]models.Job, error)
+	RestoreWithRevision(job *models.Job, revision *models.JobRevision) error
+	PurgeDeletedBefore(cutoff time.Time) (int64, error)
+	GetClosingSoon(before time.Time) ([]models.Job, error)
+	MarkClosingNoticeSent(id uint) error
+	GetExpiredUnnotified() ([]models.Job, error)
+	MarkExpiryNoticeSent(id uint) error
 	GetAll() ([]models.Job, error)
 	GetWithFilters(filters JobFilters) ([]models.Job, error)
 	CountByEmployerID(employerID uint) (int64, error)
@@ -45,9 +69,24 @@ func (r *jobRepository) Create(job *models.Job) error {
 	return r.db.Create(job).Error
 }
 
+func (r *jobRepository) CreateWithRevision(job *models.Job, revision *models.JobRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(job).Error; err != nil {
+			return err
+		}
+		return createJobRevision(tx, job.ID, revision)
+	})
+}
+
+// withOpenings loads how many of the job's openings are filled and remaining
+func withOpenings(db *gorm.DB) *gorm.DB {
+	return db.Select("jobs.*, " + filledOpeningsSQL + " AS filled_openings, " +
+		"GREATEST(jobs.openings - " + filledOpeningsSQL + ", 0) AS remaining_openings")
+}
+
 func (r *jobRepository) GetByID(id uint) (*models.Job, error) {
 	var job models.Job
-	err := r.db.Preload("Employer").First(&job, id).Error
+	err := r.db.Scopes(withOpenings).Preload("Employer").Preload("ScreeningQuestions", orderByPosition).First(&job, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -64,10 +103,139 @@ func (r *jobRepository) Update(job *models.Job) error {
 	return r.db.Save(job).Error
 }
 
+func (r *jobRepository) UpdateWithRevision(job *models.Job, revision *models.JobRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("ScreeningQuestions").Save(job).Error; err != nil {
+			return err
+		}
+		return createJobRevision(tx, job.ID, revision)
+	})
+}
+
+// ReplaceScreeningQuestions saves the job and swaps its screening questions
+// for the given set in a single transaction.
+func (r *jobRepository) ReplaceScreeningQuestions(job *models.Job, questions []models.ScreeningQuestion, revision *models.JobRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("ScreeningQuestions").Save(job).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("job_id = ?", job.ID).Delete(&models.ScreeningQuestion{}).Error; err != nil {
+			return err
+		}
+		for i := range questions {
+			questions[i].ID = 0
+			questions[i].JobID = job.ID
+		}
+		if len(questions) > 0 {
+			if err := tx.Create(&questions).Error; err != nil {
+				return err
+			}
+		}
+		job.ScreeningQuestions = questions
+		return createJobRevision(tx, job.ID, revision)
+	})
+}
+
+// HasApplications reports whether anyone has applied to the job, including
+// applications that were later withdrawn.
+func (r *jobRepository) HasApplications(jobID uint) (bool, error) {
+	var count int64
+	err := r.db.Unscoped().Model(&models.Application{}).Where("job_id = ?", jobID).Count(&count).Error
+	return count > 0, err
+}
+
+func orderByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
+
 func (r *jobRepository) Delete(id uint) error {
 	return r.db.Delete(&models.Job{}, id).Error
 }
 
+func (r *jobRepository) DeleteWithRevision(job *models.Job, revision *models.JobRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := createJobRevision(tx, job.ID, revision); err != nil {
+			return err
+		}
+		return tx.Delete(&models.Job{}, job.ID).Error
+	})
+}
+
+func (r *jobRepository) GetDeletedByID(id uint) (*models.Job, error) {
+	var job models.Job
+	err := r.db.Unscoped().Preload("Employer").Preload("ScreeningQuestions", orderByPosition).
+		Where("deleted_at IS NOT NULL").
+		First(&job, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *jobRepository) GetDeletedByEmployerID(employerID uint) ([]models.Job, error) {
+	var jobs []models.Job
+	err := r.db.Unscoped().
+		Where("employer_id = ? AND deleted_at IS NOT NULL", employerID).
+		Order("deleted_at DESC").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *jobRepository) RestoreWithRevision(job *models.Job, revision *models.JobRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Unscoped().Model(&models.Job{}).
+			Where("id = ?", job.ID).
+			Update("deleted_at", nil).Error
+		if err != nil {
+			return err
+		}
+		job.DeletedAt = gorm.DeletedAt{}
+		return createJobRevision(tx, job.ID, revision)
+	})
+}
+
+// PurgeDeletedBefore permanently removes jobs soft-deleted before the cutoff.
+// Jobs that still have applications are kept so candidate history survives.
+func (r *jobRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Where("NOT EXISTS (SELECT 1 FROM applications WHERE applications.job_id = jobs.id)").
+		Delete(&models.Job{})
+	return result.RowsAffected, result.Error
+}
+
+// GetClosingSoon returns published jobs whose deadline falls before the given
+// time and whose employer hasn't been told yet.
+func (r *jobRepository) GetClosingSoon(before time.Time) ([]models.Job, error) {
+	var jobs []models.Job
+	err := r.db.Preload("Employer").
+		Where("is_active = ? AND is_draft = ?", true, false).
+		Where("application_deadline > ? AND application_deadline <= ?", time.Now(), before).
+		Where("closing_notice_at IS NULL").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *jobRepository) MarkClosingNoticeSent(id uint) error {
+	return r.db.Model(&models.Job{}).Where("id = ?", id).UpdateColumn("closing_notice_at", time.Now()).Error
+}
+
+// GetExpiredUnnotified returns published jobs whose deadline has passed and
+// whose employer hasn't been emailed about it yet.
+func (r *jobRepository) GetExpiredUnnotified() ([]models.Job, error) {
+	var jobs []models.Job
+	err := r.db.Preload("Employer").
+		Where("is_active = ? AND is_draft = ?", true, false).
+		Where("application_deadline <= ?", time.Now()).
+		Where("expiry_notice_at IS NULL").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+func (r *jobRepository) MarkExpiryNoticeSent(id uint) error {
+	return r.db.Model(&models.Job{}).Where("id = ?", id).UpdateColumn("expiry_notice_at", time.Now()).Error
+}
+
 func (r *jobRepository) GetAll() ([]models.Job, error) {
 	var jobs []models.Job
 	err := r.db.Preload("Employer").Where("is_active = ?", true).Order("created_at DESC").Find(&jobs).Error
@@ -95,9 +263,10 @@ func (r *jobRepository) GetWithFilters(filters JobFilters) ([]models.Job, error)
 
 	// Only apply IsActive filter if explicitly provided
 	builder = builder.WithIsActive(filters.IsActive)
+	builder = builder.WithIsDraft(filters.IsDraft)
 
 	var jobs []models.Job
-	err := builder.Build().Preload("Employer").Find(&jobs).Error
+	err := builder.Build().Scopes(withOpenings).Preload("Employer").Find(&jobs).Error
 	return jobs, err
 }
 
@@ -164,6 +333,13 @@ func (b *JobQueryBuilder) WithIsActive(isActive *bool) *JobQueryBuilder {
 	return b
 }
 
+func (b *JobQueryBuilder) WithIsDraft(isDraft *bool) *JobQueryBuilder {
+	if isDraft != nil {
+		b.query = b.query.Where("is_draft = ?", *isDraft)
+	}
+	return b
+}
+
 func (b *JobQueryBuilder) WithEmployerID(employerID uint) *JobQueryBuilder {
 	if employerID > 0 {
 		b.query = b.query.Where("employer_id = ?", employerID)
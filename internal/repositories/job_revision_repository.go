@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobRevisionRepository interface {
+	GetByJobID(jobID uint) ([]models.JobRevision, error)
+	GetByJobIDsSince(jobIDs []uint, since time.Time) ([]models.JobRevision, error)
+}
+
+type jobRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRevisionRepository(db *gorm.DB) JobRevisionRepository {
+	return &jobRevisionRepository{db: db}
+}
+
+func (r *jobRevisionRepository) GetByJobID(jobID uint) ([]models.JobRevision, error) {
+	var revisions []models.JobRevision
+	err := r.db.Where("job_id = ?", jobID).Order("version DESC").Find(&revisions).Error
+	return revisions, err
+}
+
+func (r *jobRevisionRepository) GetByJobIDsSince(jobIDs []uint, since time.Time) ([]models.JobRevision, error) {
+	var revisions []models.JobRevision
+	if len(jobIDs) == 0 {
+		return revisions, nil
+	}
+
+	err := r.db.Where("job_id IN ? AND created_at > ?", jobIDs, since).
+		Order("job_id, version ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+// createJobRevision stores a revision with the next version number for its job.
+// It is expected to run inside the transaction that persisted the job change.
+// The job row is locked first so concurrent edits of the same job take
+// versions one after another instead of colliding on the same number.
+func createJobRevision(tx *gorm.DB, jobID uint, revision *models.JobRevision) error {
+	var lockedID uint
+	err := tx.Unscoped().Model(&models.Job{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", jobID).
+		Select("id").
+		Scan(&lockedID).Error
+	if err != nil {
+		return err
+	}
+
+	var latestVersion int
+	err = tx.Model(&models.JobRevision{}).
+		Where("job_id = ?", jobID).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&latestVersion).Error
+	if err != nil {
+		return err
+	}
+
+	revision.JobID = jobID
+	revision.Version = latestVersion + 1
+	return tx.Create(revision).Error
+}
@@ -6,13 +6,30 @@ import (
 	"gorm.io/gorm"
 )
 
+type TalentFilters struct {
+	Skills       []string // candidates must have all of them
+	Software     []string // from the student profile, all required
+	DesiredField string
+	City         string
+	SeekerType   string
+	College      string
+	Degree       string
+	Offset       int
+	Limit        int
+}
+
 type JobSeekerRepository interface {
 	Create(jobSeeker *models.JobSeeker) error
 	GetByUserID(userID uint) (*models.JobSeeker, error)
 	GetByID(id uint) (*models.JobSeeker, error)
 	Update(jobSeeker *models.JobSeeker) error
 	Delete(id uint) error
-	GetWithStudentProfile(userID uint) (*models.JobSeeker, error)
+	GetWithExtensions(userID uint) (*models.JobSeeker, error)
+	GetByPortfolioShareToken(token string) (*models.JobSeeker, error)
+	GetByProfileSlug(slug string) (*models.JobSeeker, error)
+	ProfileSlugExists(slug string, excludeID uint) (bool, error)
+	SetPortfolioShareToken(id uint, token *string) error
+	SearchTalent(filters TalentFilters) ([]models.JobSeeker, int64, error)
 }
 
 type jobSeekerRepository struct {
@@ -53,11 +70,102 @@ func (r *jobSeekerRepository) Delete(id uint) error {
 	return r.db.Delete(&models.JobSeeker{}, id).Error
 }
 
-func (r *jobSeekerRepository) GetWithStudentProfile(userID uint) (*models.JobSeeker, error) {
+// withExtensions loads the job seeker's profile extensions and portfolio
+func withExtensions(db *gorm.DB) *gorm.DB {
+	return db.Preload("StudentProfile").
+		Preload("StudentProfile.Internships", entriesOfType(models.EntryTypeInternship)).
+		Preload("StudentProfile.FreelanceProjects", entriesOfType(models.EntryTypeFreelanceProject)).
+		Preload("ProfessionalProfile").
+		Preload("ProfessionalProfile.WorkHistory", workHistoryOrder).
+		Preload("FreelancerProfile").
+		Preload("PortfolioProjects", orderByPortfolioPosition).
+		Preload("PortfolioProjects.Media", orderByPortfolioPosition)
+}
+
+// GetWithExtensions loads the job seeker with whichever profile extension
+// matches their type
+func (r *jobSeekerRepository) GetWithExtensions(userID uint) (*models.JobSeeker, error) {
 	var jobSeeker models.JobSeeker
-	err := r.db.Preload("StudentProfile").Where("user_id = ?", userID).First(&jobSeeker).Error
+	err := r.db.Scopes(withExtensions).Where("user_id = ?", userID).First(&jobSeeker).Error
 	if err != nil {
 		return nil, err
 	}
 	return &jobSeeker, nil
-}
\ No newline at end of file
+}
+
+func (r *jobSeekerRepository) GetByPortfolioShareToken(token string) (*models.JobSeeker, error) {
+	var jobSeeker models.JobSeeker
+	err := r.db.Where("portfolio_share_token = ?", token).First(&jobSeeker).Error
+	if err != nil {
+		return nil, err
+	}
+	return &jobSeeker, nil
+}
+
+// GetByProfileSlug loads a job seeker with extensions for their public profile
+func (r *jobSeekerRepository) GetByProfileSlug(slug string) (*models.JobSeeker, error) {
+	var jobSeeker models.JobSeeker
+	err := r.db.Scopes(withExtensions).Where("profile_slug = ?", slug).First(&jobSeeker).Error
+	if err != nil {
+		return nil, err
+	}
+	return &jobSeeker, nil
+}
+
+func (r *jobSeekerRepository) ProfileSlugExists(slug string, excludeID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.JobSeeker{}).Where("profile_slug = ? AND id <> ?", slug, excludeID).Count(&count).Error
+	return count > 0, err
+}
+
+// SetPortfolioShareToken replaces the public portfolio link's secret; nil
+// turns sharing off
+func (r *jobSeekerRepository) SetPortfolioShareToken(id uint, token *string) error {
+	return r.db.Model(&models.JobSeeker{}).Where("id = ?", id).Update("portfolio_share_token", token).Error
+}
+
+// SearchTalent finds job seekers who are open to opportunities, most
+// recently updated first
+func (r *jobSeekerRepository) SearchTalent(filters TalentFilters) ([]models.JobSeeker, int64, error) {
+	query := r.db.Model(&models.JobSeeker{}).Where("job_seekers.open_to_opportunities = ?", true)
+
+	if filters.SeekerType != "" {
+		query = query.Where("job_seekers.job_seeker_type = ?", filters.SeekerType)
+	}
+	if filters.DesiredField != "" {
+		query = query.Where("job_seekers.desired_field ILIKE ?", "%"+filters.DesiredField+"%")
+	}
+	if filters.City != "" {
+		query = query.Where("job_seekers.current_city ILIKE ?", "%"+filters.City+"%")
+	}
+	for _, skill := range filters.Skills {
+		query = query.Where("EXISTS (SELECT 1 FROM json_array_elements_text(COALESCE(job_seekers.skills, '[]'::json)) AS own(skill) WHERE LOWER(own.skill) = LOWER(?))", skill)
+	}
+	if filters.College != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM student_profiles WHERE student_profiles.job_seeker_id = job_seekers.id AND student_profiles.deleted_at IS NULL AND student_profiles.college_name ILIKE ?)", "%"+filters.College+"%")
+	}
+	if filters.Degree != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM student_profiles WHERE student_profiles.job_seeker_id = job_seekers.id AND student_profiles.deleted_at IS NULL AND student_profiles.degree ILIKE ?)", "%"+filters.Degree+"%")
+	}
+	for _, software := range filters.Software {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM student_profiles, json_array_elements_text(COALESCE(student_profiles.software_proficiency, '[]'::json)) AS tool(name)
+			WHERE student_profiles.job_seeker_id = job_seekers.id AND student_profiles.deleted_at IS NULL AND LOWER(tool.name) = LOWER(?)
+		)`, software)
+	}
+
+	base := query.Session(&gorm.Session{})
+
+	var total int64
+	if err := base.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var jobSeekers []models.JobSeeker
+	err := base.Preload("StudentProfile").
+		Order("job_seekers.updated_at DESC, job_seekers.id").
+		Offset(filters.Offset).
+		Limit(filters.Limit).
+		Find(&jobSeekers).Error
+	return jobSeekers, total, err
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	Create(notification *models.Notification) error
+	GetByUserID(userID uint, unreadOnly bool, offset int, limit int) ([]models.Notification, int64, error)
+	MarkRead(userID uint, id uint) (int64, error)
+	MarkAllRead(userID uint) error
+	CountUnread(userID uint) (int64, error)
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) Create(notification *models.Notification) error {
+	return r.db.Create(notification).Error
+}
+
+func (r *notificationRepository) GetByUserID(userID uint, unreadOnly bool, offset int, limit int) ([]models.Notification, int64, error) {
+	query := r.db.Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var notifications []models.Notification
+	err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&notifications).Error
+	return notifications, total, err
+}
+
+// MarkRead returns the number of rows updated so callers can tell a missing
+// or foreign notification apart from one that was already read.
+func (r *notificationRepository) MarkRead(userID uint, id uint) (int64, error) {
+	result := r.db.Model(&models.Notification{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", time.Now()))
+	return result.RowsAffected, result.Error
+}
+
+func (r *notificationRepository) MarkAllRead(userID uint) error {
+	return r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now()).Error
+}
+
+func (r *notificationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userID).Count(&count).Error
+	return count, err
+}
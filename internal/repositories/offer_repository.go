@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type OfferRepository interface {
+	Create(offer *models.Offer) error
+	GetByID(id uint) (*models.Offer, error)
+	GetByApplicationID(applicationID uint) ([]models.Offer, error)
+	GetByJobSeekerID(jobSeekerID uint) ([]models.Offer, error)
+	HasActiveOffer(applicationID uint) (bool, error)
+	Update(offer *models.Offer) error
+	ExpireOverdue() error
+	CountByStatusForEmployer(employerID uint) (map[string]int64, error)
+}
+
+type offerRepository struct {
+	db *gorm.DB
+}
+
+func NewOfferRepository(db *gorm.DB) OfferRepository {
+	return &offerRepository{db: db}
+}
+
+func (r *offerRepository) Create(offer *models.Offer) error {
+	return r.db.Omit("Application").Create(offer).Error
+}
+
+func (r *offerRepository) GetByID(id uint) (*models.Offer, error) {
+	var offer models.Offer
+	err := r.db.Preload("Application").
+		Preload("Application.Job", withDeleted).
+		Preload("Application.Job.Employer").
+		Preload("Application.JobSeeker").
+		First(&offer, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &offer, nil
+}
+
+func (r *offerRepository) GetByApplicationID(applicationID uint) ([]models.Offer, error) {
+	var offers []models.Offer
+	err := r.db.Where("application_id = ?", applicationID).Order("created_at DESC").Find(&offers).Error
+	return offers, err
+}
+
+func (r *offerRepository) GetByJobSeekerID(jobSeekerID uint) ([]models.Offer, error) {
+	var offers []models.Offer
+	err := r.db.Joins("JOIN applications ON applications.id = offers.application_id").
+		Where("applications.job_seeker_id = ? AND applications.deleted_at IS NULL", jobSeekerID).
+		Preload("Application.Job", withDeleted).
+		Preload("Application.Job.Employer").
+		Order("offers.created_at DESC").
+		Find(&offers).Error
+	return offers, err
+}
+
+// HasActiveOffer reports whether the application has an offer that is still
+// open or has been accepted
+func (r *offerRepository) HasActiveOffer(applicationID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Offer{}).
+		Where("application_id = ? AND status IN ?", applicationID, []string{models.OfferStatusPending, models.OfferStatusAccepted}).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *offerRepository) Update(offer *models.Offer) error {
+	return r.db.Omit("Application").Save(offer).Error
+}
+
+// ExpireOverdue marks pending offers past their expiry as expired
+func (r *offerRepository) ExpireOverdue() error {
+	return r.db.Model(&models.Offer{}).
+		Where("status = ? AND expires_at <= ?", models.OfferStatusPending, time.Now()).
+		Updates(map[string]interface{}{"status": models.OfferStatusExpired, "updated_at": time.Now()}).Error
+}
+
+func (r *offerRepository) CountByStatusForEmployer(employerID uint) (map[string]int64, error) {
+	var rows []struct {
+		Status string
+		Count  int64
+	}
+	err := r.db.Model(&models.Offer{}).
+		Select("offers.status, COUNT(*) AS count").
+		Joins("JOIN applications ON applications.id = offers.application_id").
+		Joins("JOIN jobs ON jobs.id = applications.job_id").
+		Where("jobs.employer_id = ?", employerID).
+		Group("offers.status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type PipelineStageRepository interface {
+	GetByJobID(jobID uint) ([]models.PipelineStage, error)
+	ReplaceForJob(jobID uint, stages []models.PipelineStage) error
+	GetStagesInUse(jobID uint) ([]string, error)
+}
+
+type pipelineStageRepository struct {
+	db *gorm.DB
+}
+
+func NewPipelineStageRepository(db *gorm.DB) PipelineStageRepository {
+	return &pipelineStageRepository{db: db}
+}
+
+func (r *pipelineStageRepository) GetByJobID(jobID uint) ([]models.PipelineStage, error) {
+	var stages []models.PipelineStage
+	err := r.db.Where("job_id = ?", jobID).Order("position ASC").Find(&stages).Error
+	return stages, err
+}
+
+func (r *pipelineStageRepository) ReplaceForJob(jobID uint, stages []models.PipelineStage) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("job_id = ?", jobID).Delete(&models.PipelineStage{}).Error; err != nil {
+			return err
+		}
+		if len(stages) == 0 {
+			return nil
+		}
+		for i := range stages {
+			stages[i].JobID = jobID
+		}
+		return tx.Create(&stages).Error
+	})
+}
+
+// GetStagesInUse returns the stages that at least one application of the job
+// is currently in, including withdrawn applications that may be restored.
+func (r *pipelineStageRepository) GetStagesInUse(jobID uint) ([]string, error) {
+	var stages []string
+	err := r.db.Unscoped().Model(&models.Application{}).
+		Where("job_id = ?", jobID).
+		Distinct("stage").
+		Pluck("stage", &stages).Error
+	return stages, err
+}
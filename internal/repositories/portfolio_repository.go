@@ -0,0 +1,157 @@
+package repositories
+
+import (
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type PortfolioRepository interface {
+	GetProjects(jobSeekerID uint) ([]models.PortfolioProject, error)
+	GetProject(jobSeekerID uint, projectID uint) (*models.PortfolioProject, error)
+	GetProjectsByIDs(jobSeekerID uint, projectIDs []uint) ([]models.PortfolioProject, error)
+	CountProjects(jobSeekerID uint) (int64, error)
+	CreateProject(project *models.PortfolioProject) error
+	UpdateProject(project *models.PortfolioProject) error
+	DeleteProject(projectID uint) error
+	ReorderProjects(jobSeekerID uint, projectIDs []uint) error
+	AddMedia(media []models.PortfolioMedia) error
+	DeleteMedia(projectID uint, mediaID uint) (bool, error)
+	ReorderMedia(projectID uint, mediaIDs []uint) error
+}
+
+type portfolioRepository struct {
+	db *gorm.DB
+}
+
+func NewPortfolioRepository(db *gorm.DB) PortfolioRepository {
+	return &portfolioRepository{db: db}
+}
+
+// orderByPortfolioPosition sorts projects or media as their owner arranged them
+func orderByPortfolioPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC, id ASC")
+}
+
+func (r *portfolioRepository) GetProjects(jobSeekerID uint) ([]models.PortfolioProject, error) {
+	var projects []models.PortfolioProject
+	err := r.db.Preload("Media", orderByPortfolioPosition).
+		Scopes(orderByPortfolioPosition).
+		Where("job_seeker_id = ?", jobSeekerID).
+		Find(&projects).Error
+	return projects, err
+}
+
+func (r *portfolioRepository) GetProject(jobSeekerID uint, projectID uint) (*models.PortfolioProject, error) {
+	var project models.PortfolioProject
+	err := r.db.Preload("Media", orderByPortfolioPosition).
+		Where("job_seeker_id = ?", jobSeekerID).
+		First(&project, projectID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// GetProjectsByIDs returns those of the given projects that belong to the
+// job seeker, without their media
+func (r *portfolioRepository) GetProjectsByIDs(jobSeekerID uint, projectIDs []uint) ([]models.PortfolioProject, error) {
+	var projects []models.PortfolioProject
+	err := r.db.Scopes(orderByPortfolioPosition).
+		Where("job_seeker_id = ? AND id IN ?", jobSeekerID, projectIDs).
+		Find(&projects).Error
+	return projects, err
+}
+
+func (r *portfolioRepository) CountProjects(jobSeekerID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.PortfolioProject{}).Where("job_seeker_id = ?", jobSeekerID).Count(&count).Error
+	return count, err
+}
+
+// CreateProject adds the project after the job seeker's existing ones
+func (r *portfolioRepository) CreateProject(project *models.PortfolioProject) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var last struct{ Position *int }
+		err := tx.Model(&models.PortfolioProject{}).
+			Select("MAX(position) AS position").
+			Where("job_seeker_id = ?", project.JobSeekerID).
+			Scan(&last).Error
+		if err != nil {
+			return err
+		}
+		if last.Position != nil {
+			project.Position = *last.Position + 1
+		}
+		return tx.Omit("Media").Create(project).Error
+	})
+}
+
+func (r *portfolioRepository) UpdateProject(project *models.PortfolioProject) error {
+	return r.db.Omit("Media").Save(project).Error
+}
+
+func (r *portfolioRepository) DeleteProject(projectID uint) error {
+	return r.db.Delete(&models.PortfolioProject{}, projectID).Error
+}
+
+// ReorderProjects sets each project's position to its index in projectIDs
+func (r *portfolioRepository) ReorderProjects(jobSeekerID uint, projectIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for position, id := range projectIDs {
+			err := tx.Model(&models.PortfolioProject{}).
+				Where("id = ? AND job_seeker_id = ?", id, jobSeekerID).
+				Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// AddMedia appends the items to their project after any existing media
+func (r *portfolioRepository) AddMedia(media []models.PortfolioMedia) error {
+	if len(media) == 0 {
+		return nil
+	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var last struct{ Position *int }
+		err := tx.Model(&models.PortfolioMedia{}).
+			Select("MAX(position) AS position").
+			Where("project_id = ?", media[0].ProjectID).
+			Scan(&last).Error
+		if err != nil {
+			return err
+		}
+		next := 0
+		if last.Position != nil {
+			next = *last.Position + 1
+		}
+		for i := range media {
+			media[i].Position = next + i
+		}
+		return tx.Create(&media).Error
+	})
+}
+
+// DeleteMedia removes one media item of the project, reporting whether it existed
+func (r *portfolioRepository) DeleteMedia(projectID uint, mediaID uint) (bool, error) {
+	result := r.db.Where("project_id = ?", projectID).Delete(&models.PortfolioMedia{}, mediaID)
+	return result.RowsAffected > 0, result.Error
+}
+
+// ReorderMedia sets each media item's position to its index in mediaIDs
+func (r *portfolioRepository) ReorderMedia(projectID uint, mediaIDs []uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for position, id := range mediaIDs {
+			err := tx.Model(&models.PortfolioMedia{}).
+				Where("id = ? AND project_id = ?", id, projectID).
+				Update("position", position).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
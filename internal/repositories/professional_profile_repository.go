@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ProfessionalProfileRepository interface {
+	Create(profile *models.ProfessionalProfile) error
+	GetByJobSeekerID(jobSeekerID uint) (*models.ProfessionalProfile, error)
+	Update(profile *models.ProfessionalProfile) error
+	ReplaceWorkHistory(profile *models.ProfessionalProfile, history []models.WorkHistoryEntry) error
+	Delete(jobSeekerID uint) error
+	GetDeletedByJobSeekerID(jobSeekerID uint) (*models.ProfessionalProfile, error)
+	Restore(id uint) error
+	PurgeDeletedBefore(cutoff time.Time) (int64, error)
+}
+
+type professionalProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewProfessionalProfileRepository(db *gorm.DB) ProfessionalProfileRepository {
+	return &professionalProfileRepository{db: db}
+}
+
+// workHistoryOrder lists the current position first, then by start date
+func workHistoryOrder(db *gorm.DB) *gorm.DB {
+	return db.Order("end_date DESC NULLS FIRST, start_date DESC, id")
+}
+
+func (r *professionalProfileRepository) Create(profile *models.ProfessionalProfile) error {
+	return r.db.Omit("JobSeeker").Create(profile).Error
+}
+
+func (r *professionalProfileRepository) GetByJobSeekerID(jobSeekerID uint) (*models.ProfessionalProfile, error) {
+	var profile models.ProfessionalProfile
+	err := r.db.Preload("JobSeeker").
+		Preload("WorkHistory", workHistoryOrder).
+		Where("job_seeker_id = ?", jobSeekerID).
+		First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *professionalProfileRepository) Update(profile *models.ProfessionalProfile) error {
+	return r.db.Omit("JobSeeker", "WorkHistory").Save(profile).Error
+}
+
+// ReplaceWorkHistory saves the profile and swaps its work history in one go
+func (r *professionalProfileRepository) ReplaceWorkHistory(profile *models.ProfessionalProfile, history []models.WorkHistoryEntry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("professional_profile_id = ?", profile.ID).Delete(&models.WorkHistoryEntry{}).Error; err != nil {
+			return err
+		}
+		if len(history) > 0 {
+			for i := range history {
+				history[i].ProfessionalProfileID = profile.ID
+			}
+			if err := tx.Create(&history).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Omit("JobSeeker", "WorkHistory").Save(profile).Error; err != nil {
+			return err
+		}
+		profile.WorkHistory = history
+		return nil
+	})
+}
+
+func (r *professionalProfileRepository) Delete(jobSeekerID uint) error {
+	return r.db.Where("job_seeker_id = ?", jobSeekerID).Delete(&models.ProfessionalProfile{}).Error
+}
+
+func (r *professionalProfileRepository) GetDeletedByJobSeekerID(jobSeekerID uint) (*models.ProfessionalProfile, error) {
+	var profile models.ProfessionalProfile
+	err := r.db.Unscoped().
+		Where("job_seeker_id = ? AND deleted_at IS NOT NULL", jobSeekerID).
+		Order("deleted_at DESC").
+		First(&profile).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *professionalProfileRepository) Restore(id uint) error {
+	return r.db.Unscoped().Model(&models.ProfessionalProfile{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error
+}
+
+func (r *professionalProfileRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Delete(&models.ProfessionalProfile{})
+	return result.RowsAffected, result.Error
+}
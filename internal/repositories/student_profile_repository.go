@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 
 	"gorm.io/gorm"
@@ -12,6 +14,14 @@ type StudentProfileRepository interface {
 	Update(profile *models.StudentProfile) error
 	Delete(jobSeekerID uint) error
 	GetByID(id uint) (*models.StudentProfile, error)
+	GetDeletedByJobSeekerID(jobSeekerID uint) (*models.StudentProfile, error)
+	Restore(id uint) error
+	PurgeDeletedBefore(cutoff time.Time) (int64, error)
+	GetEntries(profileID uint, entryType string) ([]models.StudentProfileEntry, error)
+	GetEntry(profileID uint, entryType string, id uint) (*models.StudentProfileEntry, error)
+	CreateEntry(entry *models.StudentProfileEntry) error
+	UpdateEntry(entry *models.StudentProfileEntry) error
+	DeleteEntry(id uint) error
 }
 
 type studentProfileRepository struct {
@@ -22,13 +32,26 @@ func NewStudentProfileRepository(db *gorm.DB) StudentProfileRepository {
 	return &studentProfileRepository{db: db}
 }
 
+// entriesOfType preloads one kind of profile entry, most recent first
+func entriesOfType(entryType string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("entry_type = ?", entryType).Order("start_date DESC NULLS LAST, id")
+	}
+}
+
+// withStudentEntries loads the profile's internships and freelance projects
+func withStudentEntries(db *gorm.DB) *gorm.DB {
+	return db.Preload("Internships", entriesOfType(models.EntryTypeInternship)).
+		Preload("FreelanceProjects", entriesOfType(models.EntryTypeFreelanceProject))
+}
+
 func (r *studentProfileRepository) Create(profile *models.StudentProfile) error {
-	return r.db.Create(profile).Error
+	return r.db.Omit("Internships", "FreelanceProjects").Create(profile).Error
 }
 
 func (r *studentProfileRepository) GetByJobSeekerID(jobSeekerID uint) (*models.StudentProfile, error) {
 	var profile models.StudentProfile
-	err := r.db.Preload("JobSeeker").Where("job_seeker_id = ?", jobSeekerID).First(&profile).Error
+	err := r.db.Preload("JobSeeker").Scopes(withStudentEntries).Where("job_seeker_id = ?", jobSeekerID).First(&profile).Error
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +59,7 @@ func (r *studentProfileRepository) GetByJobSeekerID(jobSeekerID uint) (*models.S
 }
 
 func (r *studentProfileRepository) Update(profile *models.StudentProfile) error {
-	return r.db.Save(profile).Error
+	return r.db.Omit("Internships", "FreelanceProjects").Save(profile).Error
 }
 
 func (r *studentProfileRepository) Delete(jobSeekerID uint) error {
@@ -45,9 +68,63 @@ func (r *studentProfileRepository) Delete(jobSeekerID uint) error {
 
 func (r *studentProfileRepository) GetByID(id uint) (*models.StudentProfile, error) {
 	var profile models.StudentProfile
-	err := r.db.Preload("JobSeeker").First(&profile, id).Error
+	err := r.db.Preload("JobSeeker").Scopes(withStudentEntries).First(&profile, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *studentProfileRepository) GetDeletedByJobSeekerID(jobSeekerID uint) (*models.StudentProfile, error) {
+	var profile models.StudentProfile
+	err := r.db.Unscoped().
+		Where("job_seeker_id = ? AND deleted_at IS NOT NULL", jobSeekerID).
+		Order("deleted_at DESC").
+		First(&profile).Error
 	if err != nil {
 		return nil, err
 	}
 	return &profile, nil
-}
\ No newline at end of file
+}
+
+func (r *studentProfileRepository) Restore(id uint) error {
+	return r.db.Unscoped().Model(&models.StudentProfile{}).
+		Where("id = ?", id).
+		Update("deleted_at", nil).Error
+}
+
+func (r *studentProfileRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Delete(&models.StudentProfile{})
+	return result.RowsAffected, result.Error
+}
+
+func (r *studentProfileRepository) GetEntries(profileID uint, entryType string) ([]models.StudentProfileEntry, error) {
+	var entries []models.StudentProfileEntry
+	err := r.db.Scopes(entriesOfType(entryType)).
+		Where("student_profile_id = ?", profileID).
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *studentProfileRepository) GetEntry(profileID uint, entryType string, id uint) (*models.StudentProfileEntry, error) {
+	var entry models.StudentProfileEntry
+	err := r.db.Where("student_profile_id = ? AND entry_type = ?", profileID, entryType).First(&entry, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *studentProfileRepository) CreateEntry(entry *models.StudentProfileEntry) error {
+	return r.db.Create(entry).Error
+}
+
+func (r *studentProfileRepository) UpdateEntry(entry *models.StudentProfileEntry) error {
+	return r.db.Save(entry).Error
+}
+
+func (r *studentProfileRepository) DeleteEntry(id uint) error {
+	return r.db.Delete(&models.StudentProfileEntry{}, id).Error
+}
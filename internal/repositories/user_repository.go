@@ -12,6 +12,9 @@ type UserRepository interface {
 	GetByID(id uint) (*models.User, error)
 	Update(user *models.User) error
 	EmailExists(email string) bool
+	GetByCalendarToken(token string) (*models.User, error)
+	SetCalendarToken(userID uint, token string) error
+	SetPhoto(userID uint, url string, thumbURL string) error
 }
 
 type userRepository struct {
@@ -52,4 +55,25 @@ func (r *userRepository) EmailExists(email string) bool {
 	var count int64
 	r.db.Model(&models.User{}).Where("email = ?", email).Count(&count)
 	return count > 0
-}
\ No newline at end of file
+}
+
+func (r *userRepository) GetByCalendarToken(token string) (*models.User, error) {
+	var user models.User
+	err := r.db.Where("calendar_token = ?", token).First(&user).Error
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) SetCalendarToken(userID uint, token string) error {
+	return r.db.Model(&models.User{}).Where("id = ?", userID).Update("calendar_token", token).Error
+}
+
+// SetPhoto stores the uploaded photo's URLs; empty URLs remove it
+func (r *userRepository) SetPhoto(userID uint, url string, thumbURL string) error {
+	return r.db.Model(&models.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+		"photo_url":       url,
+		"photo_thumb_url": thumbURL,
+	}).Error
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+)
+
+type ApplicationNoteRequest struct {
+	Body string `json:"body" binding:"required,max=5000"`
+}
+
+type RateApplicationRequest struct {
+	Rating int `json:"rating" binding:"required,min=1,max=5"`
+}
+
+type SetApplicationTagsRequest struct {
+	Tags []string `json:"tags" binding:"max=20,dive,max=50"`
+}
+
+// ApplicationReview is everything the employer has recorded about an
+// application. It is never shown to the candidate.
+type ApplicationReview struct {
+	Notes  []models.ApplicationNote `json:"notes"`
+	Rating int                      `json:"rating"` // 1-5, or 0 when not rated yet
+	Tags   []models.ApplicationTag  `json:"tags"`
+}
+
+type ApplicationReviewService interface {
+	GetReview(employerID uint, applicationID uint) (*ApplicationReview, error)
+	AddNote(employerID uint, userID uint, applicationID uint, req ApplicationNoteRequest) (*models.ApplicationNote, error)
+	UpdateNote(employerID uint, userID uint, applicationID uint, noteID uint, req ApplicationNoteRequest) (*models.ApplicationNote, error)
+	DeleteNote(employerID uint, userID uint, applicationID uint, noteID uint) error
+	RateApplication(employerID uint, userID uint, applicationID uint, req RateApplicationRequest) (*ApplicationReview, error)
+	SetTags(employerID uint, userID uint, applicationID uint, req SetApplicationTagsRequest) ([]models.ApplicationTag, error)
+}
+
+type applicationReviewService struct {
+	reviewRepo      repositories.ApplicationReviewRepository
+	applicationRepo repositories.ApplicationRepository
+}
+
+func NewApplicationReviewService(
+	reviewRepo repositories.ApplicationReviewRepository,
+	applicationRepo repositories.ApplicationRepository,
+) ApplicationReviewService {
+	return &applicationReviewService{
+		reviewRepo:      reviewRepo,
+		applicationRepo: applicationRepo,
+	}
+}
+
+// authorize checks that the application belongs to one of the employer's jobs
+func (s *applicationReviewService) authorize(employerID uint, applicationID uint) error {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return errors.New("application not found")
+	}
+
+	if application.Job.EmployerID != employerID {
+		return errors.New("unauthorized to review this application")
+	}
+
+	return nil
+}
+
+func (s *applicationReviewService) GetReview(employerID uint, applicationID uint) (*ApplicationReview, error) {
+	if err := s.authorize(employerID, applicationID); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.reviewRepo.GetNotesByApplicationID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rating, err := s.reviewRepo.GetRating(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.reviewRepo.GetTagsByApplicationID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationReview{
+		Notes:  notes,
+		Rating: rating,
+		Tags:   tags,
+	}, nil
+}
+
+func (s *applicationReviewService) AddNote(employerID uint, userID uint, applicationID uint, req ApplicationNoteRequest) (*models.ApplicationNote, error) {
+	if err := s.authorize(employerID, applicationID); err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, errors.New("note cannot be empty")
+	}
+
+	note := &models.ApplicationNote{
+		ApplicationID: applicationID,
+		AuthorUserID:  userID,
+		Body:          body,
+	}
+	if err := s.reviewRepo.CreateNote(note); err != nil {
+		return nil, errors.New("failed to add note")
+	}
+
+	return note, nil
+}
+
+func (s *applicationReviewService) UpdateNote(employerID uint, userID uint, applicationID uint, noteID uint, req ApplicationNoteRequest) (*models.ApplicationNote, error) {
+	note, err := s.getOwnNote(employerID, userID, applicationID, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, errors.New("note cannot be empty")
+	}
+
+	note.Body = body
+	if err := s.reviewRepo.UpdateNote(note); err != nil {
+		return nil, errors.New("failed to update note")
+	}
+
+	return note, nil
+}
+
+func (s *applicationReviewService) DeleteNote(employerID uint, userID uint, applicationID uint, noteID uint) error {
+	note, err := s.getOwnNote(employerID, userID, applicationID, noteID)
+	if err != nil {
+		return err
+	}
+
+	return s.reviewRepo.DeleteNote(note.ID)
+}
+
+// getOwnNote loads a note on the application; only its author may change it
+func (s *applicationReviewService) getOwnNote(employerID uint, userID uint, applicationID uint, noteID uint) (*models.ApplicationNote, error) {
+	if err := s.authorize(employerID, applicationID); err != nil {
+		return nil, err
+	}
+
+	note, err := s.reviewRepo.GetNoteByID(noteID)
+	if err != nil || note.ApplicationID != applicationID {
+		return nil, errors.New("note not found")
+	}
+
+	if note.AuthorUserID != userID {
+		return nil, errors.New("only the author can change this note")
+	}
+
+	return note, nil
+}
+
+func (s *applicationReviewService) RateApplication(employerID uint, userID uint, applicationID uint, req RateApplicationRequest) (*ApplicationReview, error) {
+	if err := s.authorize(employerID, applicationID); err != nil {
+		return nil, err
+	}
+
+	rating := &models.ApplicationRating{
+		ApplicationID: applicationID,
+		RaterUserID:   userID,
+		Rating:        req.Rating,
+	}
+	if err := s.reviewRepo.UpsertRating(rating); err != nil {
+		return nil, errors.New("failed to save rating")
+	}
+
+	return s.GetReview(employerID, applicationID)
+}
+
+func (s *applicationReviewService) SetTags(employerID uint, userID uint, applicationID uint, req SetApplicationTagsRequest) ([]models.ApplicationTag, error) {
+	if err := s.authorize(employerID, applicationID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.reviewRepo.GetTagsByApplicationID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	createdBy := map[string]uint{}
+	for _, tag := range existing {
+		createdBy[tag.Tag] = tag.CreatedByUserID
+	}
+
+	// Tags are normalized to lowercase so "Revit" and "revit" are the same tag
+	tags := []models.ApplicationTag{}
+	seen := map[string]bool{}
+	for _, value := range req.Tags {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+
+		tag := models.ApplicationTag{Tag: value, CreatedByUserID: userID}
+		if creator, ok := createdBy[value]; ok {
+			tag.CreatedByUserID = creator
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := s.reviewRepo.ReplaceTags(applicationID, tags); err != nil {
+		return nil, errors.New("failed to update tags")
+	}
+
+	return tags, nil
+}
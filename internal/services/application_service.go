@@ -2,6 +2,9 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
@@ -10,23 +13,128 @@ import (
 	"gorm.io/gorm"
 )
 
+// ApplicationHistoryWithdrawn marks a withdrawal, and the restore after it,
+// in an application's status history. The application itself keeps its
+// status while withdrawn.
+const ApplicationHistoryWithdrawn = "withdrawn"
+
 type ApplyJobRequest struct {
-	JobID uint `json:"job_id" binding:"required"`
+	JobID               uint                   `json:"job_id" binding:"required"`
+	CoverLetter         string                 `json:"cover_letter" binding:"max=5000"`
+	Answers             []ScreeningAnswerInput `json:"answers" binding:"omitempty,dive"`
+	PortfolioProjectIDs []uint                 `json:"portfolio_project_ids" binding:"omitempty,max=10,unique"` // projects to show the employer
+	Source              string                 `json:"-"`                                                       // set by the server, e.g. when accepting an invitation
 }
 
+// UpdateApplicationStatusRequest moves an application to a pipeline stage.
+// Either the stage key or, for simple pipelines, the target status is needed.
 type UpdateApplicationStatusRequest struct {
-	Status string `json:"status" binding:"required,oneof=applied shortlisted rejected selected"`
+	Stage  string `json:"stage"`
+	Status string `json:"status" binding:"omitempty,oneof=applied shortlisted rejected selected"`
+	Note   string `json:"note" binding:"max=1000"` // optional, visible to the candidate
+}
+
+// ApplicationTimelineEntry is a candidate-facing view of a status change.
+// Internal details such as who made the change are left out.
+type ApplicationTimelineEntry struct {
+	Status string    `json:"status"`
+	Note   string    `json:"note,omitempty"`
+	At     time.Time `json:"at"`
+}
+
+// BulkUpdateApplicationStatusRequest moves many applications at once. The
+// optional message template is rendered per candidate and stored as the note
+// on their timeline.
+type BulkUpdateApplicationStatusRequest struct {
+	ApplicationIDs  []uint `json:"application_ids" binding:"required,min=1,max=500"`
+	Stage           string `json:"stage"`
+	Status          string `json:"status" binding:"omitempty,oneof=applied shortlisted rejected selected"`
+	MessageTemplate string `json:"message_template" binding:"max=2000"`
+}
+
+type BulkApplicationResult struct {
+	ApplicationID uint   `json:"application_id"`
+	Success       bool   `json:"success"`
+	Stage         string `json:"stage,omitempty"`
+	Status        string `json:"status,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+type BulkUpdateResult struct {
+	Updated int                     `json:"updated"`
+	Failed  int                     `json:"failed"`
+	Results []BulkApplicationResult `json:"results"`
+}
+
+type ApplicationDetail struct {
+	Application *models.Application        `json:"application"`
+	Timeline    []ApplicationTimelineEntry `json:"timeline"`
+}
+
+type ApplicantFilters struct {
+	Status       string `form:"status" binding:"omitempty,oneof=applied shortlisted rejected selected"`
+	Stage        string `form:"stage"`
+	SeekerType   string `form:"seeker_type" binding:"omitempty,oneof=student professional freelancer"`
+	Source       string `form:"source" binding:"omitempty,oneof=direct invited"`
+	City         string `form:"city"`
+	Skills       string `form:"skills"` // comma separated, candidates must have all of them
+	College      string `form:"college"`
+	HasPortfolio *bool  `form:"has_portfolio"`
+	Tag          string `form:"tag"`
+	SortBy       string `form:"sort_by" binding:"omitempty,oneof=applied_at match_score rating"`
+	SortOrder    string `form:"sort_order" binding:"omitempty,oneof=asc desc"`
+	Page         int    `form:"page" binding:"omitempty,min=1"`
+	PageSize     int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type Pagination struct {
+	Page       int   `json:"page"`
+	PageSize   int   `json:"page_size"`
+	Total      int64 `json:"total"`
+	TotalPages int   `json:"total_pages"`
+}
+
+const defaultPageSize = 20
+
+func newPagination(page int, pageSize int, total int64) *Pagination {
+	totalPages := int((total + int64(pageSize) - 1) / int64(pageSize))
+	return &Pagination{
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
+
+// EligibilityIssue is something that would stop the candidate applying
+type EligibilityIssue struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// JobEligibility tells a candidate up front whether they can apply to a job
+type JobEligibility struct {
+	JobID              uint               `json:"job_id"`
+	Eligible           bool               `json:"eligible"`
+	Issues             []EligibilityIssue `json:"issues"`
+	ScreeningQuestions int                `json:"screening_questions"` // answered when applying
 }
 
 type ApplicationService interface {
 	ApplyToJob(jobSeekerID uint, req ApplyJobRequest) (*models.Application, error)
+	CheckEligibility(jobSeekerID uint, jobID uint) (*JobEligibility, error)
 	GetJobSeekerApplications(jobSeekerID uint) ([]models.Application, error)
-	GetJobApplications(jobID uint, employerID uint) ([]models.Application, error)
+	GetJobApplications(jobID uint, employerID uint, filters ApplicantFilters) ([]models.Application, *Pagination, error)
 	UpdateApplicationStatus(applicationID uint, employerID uint, req UpdateApplicationStatusRequest) (*models.Application, error)
+	GetApplicationHistory(applicationID uint, employerID uint) ([]models.ApplicationStatusChange, error)
+	BulkUpdateApplicationStatus(employerID uint, req BulkUpdateApplicationStatusRequest) (*BulkUpdateResult, error)
 	GetApplication(applicationID uint) (*models.Application, error)
+	GetMyApplication(applicationID uint, jobSeekerID uint) (*ApplicationDetail, error)
 	WithdrawApplication(applicationID uint, jobSeekerID uint) error
 	GetApplicationStats(jobSeekerID uint) (map[string]interface{}, error)
 	GetJobApplicationStats(jobID uint, employerID uint) (map[string]interface{}, error)
+	GetWithdrawnApplications(jobSeekerID uint) ([]models.Application, error)
+	RestoreApplication(applicationID uint, jobSeekerID uint) (*models.Application, error)
 }
 
 type applicationService struct {
@@ -34,6 +142,12 @@ type applicationService struct {
 	jobRepo         repositories.JobRepository
 	jobSeekerRepo   repositories.JobSeekerRepository
 	employerRepo    repositories.EmployerRepository
+	jobRevisionRepo repositories.JobRevisionRepository
+	pipelineRepo    repositories.PipelineStageRepository
+	portfolioRepo   repositories.PortfolioRepository
+	interviewRepo   repositories.InterviewRepository
+	notifications   NotificationService
+	emails          EmailService
 }
 
 func NewApplicationService(
@@ -41,12 +155,24 @@ func NewApplicationService(
 	jobRepo repositories.JobRepository,
 	jobSeekerRepo repositories.JobSeekerRepository,
 	employerRepo repositories.EmployerRepository,
+	jobRevisionRepo repositories.JobRevisionRepository,
+	pipelineRepo repositories.PipelineStageRepository,
+	portfolioRepo repositories.PortfolioRepository,
+	interviewRepo repositories.InterviewRepository,
+	notifications NotificationService,
+	emails EmailService,
 ) ApplicationService {
 	return &applicationService{
 		applicationRepo: applicationRepo,
 		jobRepo:         jobRepo,
 		jobSeekerRepo:   jobSeekerRepo,
 		employerRepo:    employerRepo,
+		jobRevisionRepo: jobRevisionRepo,
+		pipelineRepo:    pipelineRepo,
+		portfolioRepo:   portfolioRepo,
+		interviewRepo:   interviewRepo,
+		notifications:   notifications,
+		emails:          emails,
 	}
 }
 
@@ -66,49 +192,159 @@ func (s *applicationService) ApplyToJob(jobSeekerID uint, req ApplyJobRequest) (
 		return nil, err
 	}
 
-	if !job.IsActive {
-		return nil, errors.New("job is no longer active")
+	existingApp, err := s.applicationRepo.GetByJobAndJobSeeker(req.JobID, jobSeekerID)
+	alreadyApplied := err == nil && existingApp != nil
+
+	// Selected projects must be the candidate's own
+	var projects []models.PortfolioProject
+	if len(req.PortfolioProjectIDs) > 0 {
+		projects, err = s.portfolioRepo.GetProjectsByIDs(jobSeekerID, req.PortfolioProjectIDs)
+		if err != nil {
+			return nil, err
+		}
+		if len(projects) != len(req.PortfolioProjectIDs) {
+			return nil, errors.New("portfolio project not found")
+		}
 	}
 
-	// Check if application deadline has passed
-	if job.ApplicationDeadline.Before(time.Now()) {
-		return nil, errors.New("application deadline has passed")
+	// Owning projects meets a portfolio requirement, as CheckEligibility
+	// reports, whether or not any are attached to this application
+	ownedProjects, err := s.portfolioRepo.CountProjects(jobSeekerID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Check if user has already applied
-	existingApp, err := s.applicationRepo.GetByJobAndJobSeeker(req.JobID, jobSeekerID)
-	if err == nil && existingApp != nil {
-		return nil, errors.New("you have already applied to this job")
+	if issues := EligibilityIssues(job, jobSeeker, alreadyApplied, int(ownedProjects)); len(issues) > 0 {
+		return nil, errors.New(issues[0].Message)
 	}
 
-	// Validate job requirements
-	if job.ResumeRequired && jobSeeker.ResumeURL == "" {
-		return nil, errors.New("resume is required for this job")
+	answers, knockedOut, err := EvaluateScreeningAnswers(job.ScreeningQuestions, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	stages, err := loadPipeline(s.pipelineRepo, job.ID)
+	if err != nil {
+		return nil, err
 	}
 
-	if job.PortfolioRequired && jobSeeker.PortfolioURL == "" {
-		return nil, errors.New("portfolio is required for this job")
+	// New applications enter the first stage of the pipeline
+	stage := &stages[0]
+
+	// Candidates failing a knockout question are rejected straight away
+	if knockedOut {
+		if rejected := rejectionStage(stages); rejected != nil {
+			stage = rejected
+		}
 	}
 
 	// Create application
 	application := &models.Application{
-		JobID:       req.JobID,
-		JobSeekerID: jobSeekerID,
-		Status:      "applied",
-		AppliedAt:   time.Now(),
+		JobID:        req.JobID,
+		JobSeekerID:  jobSeekerID,
+		Status:       stage.Status,
+		Stage:        stage.Key,
+		CoverLetter:  strings.TrimSpace(req.CoverLetter),
+		AutoRejected: knockedOut,
+		Source:       models.ApplicationSourceDirect,
+		AppliedAt:    time.Now(),
+		Answers:      answers,
 	}
 
-	if err := s.applicationRepo.Create(application); err != nil {
+	if req.Source != "" {
+		application.Source = req.Source
+	}
+	if len(projects) > 0 {
+		application.PortfolioProjects = projects
+	}
+
+	change := &models.ApplicationStatusChange{
+		ToStage:         application.Stage,
+		ToStatus:        application.Status,
+		ChangedByUserID: jobSeeker.UserID,
+	}
+	if err := s.applicationRepo.CreateWithHistory(application, change); err != nil {
 		return nil, errors.New("failed to submit application")
 	}
 
+	s.notifications.Notify(&models.Notification{
+		UserID:        job.Employer.UserID,
+		Type:          NotificationNewApplicant,
+		Title:         "New applicant",
+		Body:          fmt.Sprintf("%s applied to %s", jobSeeker.FullName, job.Title),
+		JobID:         &job.ID,
+		ApplicationID: &application.ID,
+	})
+	s.emails.Enqueue(jobSeeker.UserID, EmailApplicationSubmitted, ApplicationEmailData{
+		CandidateName:  jobSeeker.FullName,
+		JobTitle:       job.Title,
+		CompanyName:    job.Employer.CompanyName,
+		ApplicationURL: s.emails.AppURL(fmt.Sprintf("/applications/%d", application.ID)),
+	})
+
 	// Load relationships for response
+	hideKnockoutRules(job.ScreeningQuestions)
 	application.Job = *job
 	application.JobSeeker = *jobSeeker
 
 	return application, nil
 }
 
+func (s *applicationService) CheckEligibility(jobSeekerID uint, jobID uint) (*JobEligibility, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil || job.IsDraft {
+		if err == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("job not found")
+		}
+		return nil, err
+	}
+
+	existingApp, err := s.applicationRepo.GetByJobAndJobSeeker(jobID, jobSeekerID)
+	alreadyApplied := err == nil && existingApp != nil
+
+	projects, err := s.portfolioRepo.CountProjects(jobSeekerID)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := EligibilityIssues(job, jobSeeker, alreadyApplied, int(projects))
+	return &JobEligibility{
+		JobID:              job.ID,
+		Eligible:           len(issues) == 0,
+		Issues:             issues,
+		ScreeningQuestions: len(job.ScreeningQuestions),
+	}, nil
+}
+
+// EligibilityIssues lists what stops the job seeker applying to the job, in
+// the order ApplyToJob checks them. An empty list means they can apply. A
+// portfolio requirement is met by a portfolio PDF or by owning portfolio
+// projects; portfolioProjects is how many the job seeker has.
+func EligibilityIssues(job *models.Job, jobSeeker *models.JobSeeker, alreadyApplied bool, portfolioProjects int) []EligibilityIssue {
+	issues := []EligibilityIssue{}
+	if !job.IsActive {
+		issues = append(issues, EligibilityIssue{Code: "job_inactive", Message: "job is no longer active"})
+	}
+	if job.ApplicationDeadline.Before(time.Now()) {
+		issues = append(issues, EligibilityIssue{Code: "deadline_passed", Message: "application deadline has passed"})
+	}
+	if alreadyApplied {
+		issues = append(issues, EligibilityIssue{Code: "already_applied", Message: "you have already applied to this job"})
+	}
+	if job.ResumeRequired && jobSeeker.ResumeURL == "" {
+		issues = append(issues, EligibilityIssue{Code: "resume_required", Message: "resume is required for this job"})
+	}
+	if job.PortfolioRequired && jobSeeker.PortfolioURL == "" && portfolioProjects == 0 {
+		issues = append(issues, EligibilityIssue{Code: "portfolio_required", Message: "portfolio is required for this job"})
+	}
+	return issues
+}
+
 func (s *applicationService) GetJobSeekerApplications(jobSeekerID uint) ([]models.Application, error) {
 	// Verify job seeker exists
 	_, err := s.jobSeekerRepo.GetByID(jobSeekerID)
@@ -116,21 +352,99 @@ func (s *applicationService) GetJobSeekerApplications(jobSeekerID uint) ([]model
 		return nil, errors.New("job seeker profile not found")
 	}
 
-	return s.applicationRepo.GetByJobSeekerID(jobSeekerID)
+	applications, err := s.applicationRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.attachJobChanges(applications); err != nil {
+		return nil, err
+	}
+
+	return applications, nil
 }
 
-func (s *applicationService) GetJobApplications(jobID uint, employerID uint) ([]models.Application, error) {
+// attachJobChanges tells each applicant which material job fields the
+// employer changed after the application was submitted.
+func (s *applicationService) attachJobChanges(applications []models.Application) error {
+	if len(applications) == 0 {
+		return nil
+	}
+
+	jobIDs := make([]uint, 0, len(applications))
+	earliest := applications[0].AppliedAt
+	for _, app := range applications {
+		jobIDs = append(jobIDs, app.JobID)
+		if app.AppliedAt.Before(earliest) {
+			earliest = app.AppliedAt
+		}
+	}
+
+	revisions, err := s.jobRevisionRepo.GetByJobIDsSince(jobIDs, earliest)
+	if err != nil {
+		return err
+	}
+
+	revisionsByJob := map[uint][]models.JobRevision{}
+	for _, revision := range revisions {
+		revisionsByJob[revision.JobID] = append(revisionsByJob[revision.JobID], revision)
+	}
+
+	for i := range applications {
+		var since []models.JobRevision
+		for _, revision := range revisionsByJob[applications[i].JobID] {
+			if revision.CreatedAt.After(applications[i].AppliedAt) {
+				since = append(since, revision)
+			}
+		}
+		applications[i].JobChangesSinceApplied = summarizeMaterialChanges(since)
+	}
+
+	return nil
+}
+
+func (s *applicationService) GetJobApplications(jobID uint, employerID uint, filters ApplicantFilters) ([]models.Application, *Pagination, error) {
 	// Verify job exists and belongs to employer
 	job, err := s.jobRepo.GetByID(jobID)
 	if err != nil {
-		return nil, errors.New("job not found")
+		return nil, nil, errors.New("job not found")
 	}
 
 	if job.EmployerID != employerID {
-		return nil, errors.New("unauthorized to view applications for this job")
+		return nil, nil, errors.New("unauthorized to view applications for this job")
+	}
+
+	page := filters.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := filters.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	repoFilters := repositories.ApplicantFilters{
+		Status:       filters.Status,
+		Stage:        filters.Stage,
+		SeekerType:   filters.SeekerType,
+		Source:       filters.Source,
+		City:         filters.City,
+		Skills:       splitCommaList(filters.Skills),
+		College:      filters.College,
+		HasPortfolio: filters.HasPortfolio,
+		Tag:          strings.ToLower(strings.TrimSpace(filters.Tag)),
+		SortBy:       filters.SortBy,
+		SortOrder:    filters.SortOrder,
+		Offset:       (page - 1) * pageSize,
+		Limit:        pageSize,
+	}
+
+	applications, total, err := s.applicationRepo.SearchByJobID(jobID, repoFilters)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return s.applicationRepo.GetByJobID(jobID)
+	return applications, newPagination(page, pageSize, total), nil
 }
 
 func (s *applicationService) UpdateApplicationStatus(applicationID uint, employerID uint, req UpdateApplicationStatusRequest) (*models.Application, error) {
@@ -145,17 +459,234 @@ func (s *applicationService) UpdateApplicationStatus(applicationID uint, employe
 		return nil, errors.New("unauthorized to update this application")
 	}
 
-	// Update status
-	application.Status = req.Status
-	application.UpdatedAt = time.Now()
+	if application.Job.DeletedAt.Valid {
+		return nil, errors.New("job has been deleted")
+	}
 
-	if err := s.applicationRepo.Update(application); err != nil {
+	employer, err := s.employerRepo.GetByID(employerID)
+	if err != nil {
+		return nil, errors.New("employer not found")
+	}
+
+	stages, err := loadPipeline(s.pipelineRepo, application.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := moveToStage(application, stages, req.Stage, req.Status, employer.UserID)
+	if err != nil {
+		return nil, err
+	}
+	change.CandidateNote = strings.TrimSpace(req.Note)
+
+	if err := s.applicationRepo.UpdateStatusWithHistory(application, change); err != nil {
 		return nil, errors.New("failed to update application status")
 	}
 
+	s.notifyStatusChanged(application, employer, stages, change.CandidateNote)
+
+	if application.Status == "selected" {
+		closeFilledJob(s.jobRepo, application.JobID, employer.UserID)
+	}
+	if application.Status == "rejected" {
+		s.cancelInterviews([]uint{application.ID}, "Application rejected", employer.UserID)
+	}
+
 	return application, nil
 }
 
+// cancelInterviews cancels open interviews of applications that were just
+// rejected or withdrawn. The application change is already saved, so
+// failures are only logged.
+func (s *applicationService) cancelInterviews(applicationIDs []uint, reason string, userID uint) {
+	if err := s.interviewRepo.CancelOpenForApplications(applicationIDs, reason, userID); err != nil {
+		log.Printf("Failed to cancel interviews for applications %v: %v", applicationIDs, err)
+	}
+}
+
+// notifyStatusChanged tells the candidate, in the app and by email, that their
+// application moved to a new stage
+func (s *applicationService) notifyStatusChanged(application *models.Application, employer *models.Employer, stages []models.PipelineStage, note string) {
+	stageName := application.Status
+	if stage := findStage(stages, application.Stage); stage != nil {
+		stageName = stage.Name
+	}
+
+	s.notifications.Notify(&models.Notification{
+		UserID:        application.JobSeeker.UserID,
+		Type:          NotificationStatusChanged,
+		Title:         "Application update",
+		Body:          fmt.Sprintf("Your application for %s moved to %s", application.Job.Title, stageName),
+		JobID:         &application.JobID,
+		ApplicationID: &application.ID,
+	})
+	s.emails.Enqueue(application.JobSeeker.UserID, EmailApplicationStatusChanged, ApplicationEmailData{
+		CandidateName:  application.JobSeeker.FullName,
+		JobTitle:       application.Job.Title,
+		CompanyName:    employer.CompanyName,
+		Stage:          stageName,
+		Note:           note,
+		ApplicationURL: s.emails.AppURL(fmt.Sprintf("/applications/%d", application.ID)),
+	})
+}
+
+// moveToStage applies a pipeline transition to the application and returns
+// the history entry describing it. Nothing is persisted.
+func moveToStage(application *models.Application, stages []models.PipelineStage, stage string, status string, actorUserID uint) (*models.ApplicationStatusChange, error) {
+	if stage != "" && stage == application.Stage {
+		return nil, errors.New("application is already in this stage")
+	}
+
+	// Only transitions allowed by the job's pipeline are accepted
+	next, err := ResolveStageTransition(stages, application.Stage, stage, status)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &models.ApplicationStatusChange{
+		FromStage:       application.Stage,
+		ToStage:         next.Key,
+		FromStatus:      application.Status,
+		ToStatus:        next.Status,
+		ChangedByUserID: actorUserID,
+	}
+
+	application.Status = next.Status
+	application.Stage = next.Key
+	application.UpdatedAt = time.Now()
+
+	return change, nil
+}
+
+func (s *applicationService) BulkUpdateApplicationStatus(employerID uint, req BulkUpdateApplicationStatusRequest) (*BulkUpdateResult, error) {
+	if req.Stage == "" && req.Status == "" {
+		return nil, errors.New("stage or status is required")
+	}
+
+	employer, err := s.employerRepo.GetByID(employerID)
+	if err != nil {
+		return nil, errors.New("employer not found")
+	}
+
+	message, err := parseCandidateMessage(req.MessageTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationIDs := uniqueIDs(req.ApplicationIDs)
+	found, err := s.applicationRepo.GetByIDs(applicationIDs)
+	if err != nil {
+		return nil, err
+	}
+	applicationsByID := map[uint]*models.Application{}
+	for i := range found {
+		applicationsByID[found[i].ID] = &found[i]
+	}
+
+	result := &BulkUpdateResult{Results: make([]BulkApplicationResult, 0, len(applicationIDs))}
+	pipelines := map[uint][]models.PipelineStage{}
+	var updated []*models.Application
+	var changes []*models.ApplicationStatusChange
+
+	// Every item is checked the same way as a single update; items that fail
+	// are reported and skipped while the rest are saved together.
+	for _, applicationID := range applicationIDs {
+		item := BulkApplicationResult{ApplicationID: applicationID}
+
+		application, ok := applicationsByID[applicationID]
+		switch {
+		case !ok:
+			item.Error = "application not found"
+		case application.Job.EmployerID != employerID:
+			item.Error = "unauthorized to update this application"
+		case application.Job.DeletedAt.Valid:
+			item.Error = "job has been deleted"
+		}
+		if item.Error != "" {
+			result.Results = append(result.Results, item)
+			continue
+		}
+
+		stages, ok := pipelines[application.JobID]
+		if !ok {
+			if stages, err = loadPipeline(s.pipelineRepo, application.JobID); err != nil {
+				return nil, err
+			}
+			pipelines[application.JobID] = stages
+		}
+
+		change, err := moveToStage(application, stages, req.Stage, req.Status, employer.UserID)
+		if err != nil {
+			item.Error = err.Error()
+			result.Results = append(result.Results, item)
+			continue
+		}
+
+		if message != nil {
+			note, err := renderCandidateMessage(message, application, employer, stages)
+			if err != nil {
+				item.Error = err.Error()
+				result.Results = append(result.Results, item)
+				continue
+			}
+			change.CandidateNote = note
+		}
+
+		item.Success = true
+		item.Stage = application.Stage
+		item.Status = application.Status
+		result.Results = append(result.Results, item)
+		updated = append(updated, application)
+		changes = append(changes, change)
+	}
+
+	if len(updated) > 0 {
+		if err := s.applicationRepo.UpdateStatusesWithHistory(updated, changes); err != nil {
+			return nil, errors.New("failed to update application statuses")
+		}
+		selectedJobs := map[uint]bool{}
+		rejected := []uint{}
+		for i, application := range updated {
+			s.notifyStatusChanged(application, employer, pipelines[application.JobID], changes[i].CandidateNote)
+			if application.Status == "selected" {
+				selectedJobs[application.JobID] = true
+			}
+			if application.Status == "rejected" {
+				rejected = append(rejected, application.ID)
+			}
+		}
+		for jobID := range selectedJobs {
+			closeFilledJob(s.jobRepo, jobID, employer.UserID)
+		}
+		if len(rejected) > 0 {
+			s.cancelInterviews(rejected, "Application rejected", employer.UserID)
+		}
+	}
+
+	for _, item := range result.Results {
+		if item.Success {
+			result.Updated++
+		} else {
+			result.Failed++
+		}
+	}
+
+	return result, nil
+}
+
+func (s *applicationService) GetApplicationHistory(applicationID uint, employerID uint) ([]models.ApplicationStatusChange, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if application.Job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to view this application")
+	}
+
+	return s.applicationRepo.GetStatusHistory(applicationID)
+}
+
 func (s *applicationService) GetApplication(applicationID uint) (*models.Application, error) {
 	application, err := s.applicationRepo.GetByID(applicationID)
 	if err != nil {
@@ -167,6 +698,58 @@ func (s *applicationService) GetApplication(applicationID uint) (*models.Applica
 	return application, nil
 }
 
+func (s *applicationService) GetMyApplication(applicationID uint, jobSeekerID uint) (*ApplicationDetail, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if application.JobSeekerID != jobSeekerID {
+		return nil, errors.New("unauthorized to view this application")
+	}
+
+	history, err := s.applicationRepo.GetStatusHistory(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	applications := []models.Application{*application}
+	if err := s.attachJobChanges(applications); err != nil {
+		return nil, err
+	}
+	application = &applications[0]
+
+	// Candidates see their own answers but not the knockout rules
+	for i := range application.Answers {
+		application.Answers[i].Question.AcceptedAnswers = ""
+		application.Answers[i].Question.MinValue = nil
+		application.Answers[i].Question.MaxValue = nil
+	}
+
+	return &ApplicationDetail{
+		Application: application,
+		Timeline:    buildCandidateTimeline(history),
+	}, nil
+}
+
+// buildCandidateTimeline turns the stage history into the entries a candidate
+// sees. Moves between internal stages that keep the same status are only
+// shown when the employer left a note.
+func buildCandidateTimeline(history []models.ApplicationStatusChange) []ApplicationTimelineEntry {
+	timeline := []ApplicationTimelineEntry{}
+	for _, change := range history {
+		if change.FromStatus != "" && change.FromStatus == change.ToStatus && change.CandidateNote == "" {
+			continue
+		}
+		timeline = append(timeline, ApplicationTimelineEntry{
+			Status: change.ToStatus,
+			Note:   change.CandidateNote,
+			At:     change.CreatedAt,
+		})
+	}
+	return timeline
+}
+
 func (s *applicationService) WithdrawApplication(applicationID uint, jobSeekerID uint) error {
 	// Get application
 	application, err := s.applicationRepo.GetByID(applicationID)
@@ -184,7 +767,73 @@ func (s *applicationService) WithdrawApplication(applicationID uint, jobSeekerID
 		return errors.New("cannot withdraw application that has been processed")
 	}
 
-	return s.applicationRepo.Delete(applicationID)
+	// The application keeps its status; the history shows it was withdrawn
+	change := &models.ApplicationStatusChange{
+		FromStage:       application.Stage,
+		ToStage:         application.Stage,
+		FromStatus:      application.Status,
+		ToStatus:        ApplicationHistoryWithdrawn,
+		ChangedByUserID: application.JobSeeker.UserID,
+	}
+	if err := s.applicationRepo.WithdrawWithHistory(application, change); err != nil {
+		return err
+	}
+
+	s.cancelInterviews([]uint{application.ID}, "Application withdrawn", application.JobSeeker.UserID)
+	return nil
+}
+
+func (s *applicationService) GetWithdrawnApplications(jobSeekerID uint) ([]models.Application, error) {
+	return s.applicationRepo.GetDeletedByJobSeekerID(jobSeekerID)
+}
+
+func (s *applicationService) RestoreApplication(applicationID uint, jobSeekerID uint) (*models.Application, error) {
+	application, err := s.applicationRepo.GetDeletedByID(applicationID)
+	if err != nil {
+		return nil, errors.New("withdrawn application not found")
+	}
+
+	if application.JobSeekerID != jobSeekerID {
+		return nil, errors.New("unauthorized to restore this application")
+	}
+
+	// The job must still be open for the application to be reinstated
+	job, err := s.jobRepo.GetByID(application.JobID)
+	if err != nil {
+		return nil, errors.New("job is no longer available")
+	}
+
+	if !job.IsActive {
+		return nil, errors.New("job is no longer active")
+	}
+
+	if job.ApplicationDeadline.Before(time.Now()) {
+		return nil, errors.New("application deadline has passed")
+	}
+
+	existingApp, err := s.applicationRepo.GetByJobAndJobSeeker(application.JobID, jobSeekerID)
+	if err == nil && existingApp != nil {
+		return nil, errors.New("you have already applied to this job")
+	}
+
+	jobSeeker, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	application.UpdatedAt = time.Now()
+	change := &models.ApplicationStatusChange{
+		FromStage:       application.Stage,
+		ToStage:         application.Stage,
+		FromStatus:      ApplicationHistoryWithdrawn,
+		ToStatus:        application.Status,
+		ChangedByUserID: jobSeeker.UserID,
+	}
+	if err := s.applicationRepo.RestoreWithHistory(application, change); err != nil {
+		return nil, errors.New("failed to restore application")
+	}
+
+	return s.applicationRepo.GetByID(applicationID)
 }
 
 func (s *applicationService) GetApplicationStats(jobSeekerID uint) (map[string]interface{}, error) {
@@ -202,11 +851,11 @@ func (s *applicationService) GetApplicationStats(jobSeekerID uint) (map[string]i
 
 	// Calculate stats
 	stats := map[string]interface{}{
-		"total_applications": len(applications),
-		"applied":           0,
-		"shortlisted":       0,
-		"rejected":          0,
-		"selected":          0,
+		"total_applications":  len(applications),
+		"applied":             0,
+		"shortlisted":         0,
+		"rejected":            0,
+		"selected":            0,
 		"recent_applications": applications[:min(5, len(applications))],
 	}
 
@@ -245,12 +894,12 @@ func (s *applicationService) GetJobApplicationStats(jobID uint, employerID uint)
 
 	// Calculate stats
 	stats := map[string]interface{}{
-		"total_applications": len(applications),
-		"applied":           0,
-		"shortlisted":       0,
-		"rejected":          0,
-		"selected":          0,
-		"job_title":         job.Title,
+		"total_applications":   len(applications),
+		"applied":              0,
+		"shortlisted":          0,
+		"rejected":             0,
+		"selected":             0,
+		"job_title":            job.Title,
 		"application_deadline": job.ApplicationDeadline,
 	}
 
@@ -269,4 +918,3 @@ func (s *applicationService) GetJobApplicationStats(jobID uint, employerID uint)
 
 	return stats, nil
 }
-
@@ -3,6 +3,7 @@ package services
 import (
 	"errors"
 	"regexp"
+	"strings"
 
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
@@ -15,6 +16,7 @@ type RegisterRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required,min=8"`
 	UserType string `json:"user_type" binding:"required,oneof=job_seeker employer"`
+	Locale   string `json:"locale" binding:"omitempty,max=10"`
 }
 
 type LoginRequest struct {
@@ -35,11 +37,13 @@ type AuthService interface {
 
 type authService struct {
 	userRepo repositories.UserRepository
+	emails   EmailService
 }
 
-func NewAuthService(userRepo repositories.UserRepository) AuthService {
+func NewAuthService(userRepo repositories.UserRepository, emails EmailService) AuthService {
 	return &authService{
 		userRepo: userRepo,
+		emails:   emails,
 	}
 }
 
@@ -62,12 +66,21 @@ func (s *authService) Register(req RegisterRequest) (*AuthResponse, error) {
 		PasswordHash: hashedPassword,
 		UserType:     req.UserType,
 		IsVerified:   false,
+		Locale:       defaultEmailLocale,
+	}
+	if locale := strings.TrimSpace(req.Locale); locale != "" {
+		user.Locale = strings.ToLower(locale)
 	}
 
 	if err := s.userRepo.Create(user); err != nil {
 		return nil, errors.New("failed to create user")
 	}
 
+	s.emails.Enqueue(user.ID, EmailWelcome, WelcomeEmailData{
+		UserType: user.UserType,
+		AppURL:   s.emails.AppURL("/"),
+	})
+
 	token, err := utils.GenerateJWT(user.ID, user.Email, user.UserType)
 	if err != nil {
 		return nil, errors.New("failed to generate token")
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"errors"
+	"mime/multipart"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+// Avatar is either a photo to redirect to or a generated SVG to serve
+type Avatar struct {
+	RedirectURL string
+	SVG         string
+}
+
+type AvatarService interface {
+	UploadAvatar(userID uint, file *multipart.FileHeader) (*models.User, error)
+	RemoveAvatar(userID uint) error
+	GetAvatar(userID uint, thumb bool) (*Avatar, error)
+}
+
+type avatarService struct {
+	userRepo      repositories.UserRepository
+	jobSeekerRepo repositories.JobSeekerRepository
+	employerRepo  repositories.EmployerRepository
+	fileService   FileService
+}
+
+func NewAvatarService(
+	userRepo repositories.UserRepository,
+	jobSeekerRepo repositories.JobSeekerRepository,
+	employerRepo repositories.EmployerRepository,
+	fileService FileService,
+) AvatarService {
+	return &avatarService{
+		userRepo:      userRepo,
+		jobSeekerRepo: jobSeekerRepo,
+		employerRepo:  employerRepo,
+		fileService:   fileService,
+	}
+}
+
+func (s *avatarService) UploadAvatar(userID uint, file *multipart.FileHeader) (*models.User, error) {
+	uploaded, err := s.fileService.UploadAvatar(userID, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.SetPhoto(userID, uploaded.URL, uploaded.ThumbURL); err != nil {
+		return nil, errors.New("failed to save photo")
+	}
+
+	return s.userRepo.GetByID(userID)
+}
+
+func (s *avatarService) RemoveAvatar(userID uint) error {
+	return s.userRepo.SetPhoto(userID, "", "")
+}
+
+// GetAvatar picks what to show for the user. Job seekers' photos are only
+// shown when their profile is shared (unlisted or public); private seekers get
+// their initials, and anonymous ones, like users without a profile, a blank
+// placeholder. Employers show their photo, then their company logo, then
+// initials. Only files in our own storage are ever redirected to.
+func (s *avatarService) GetAvatar(userID uint, thumb bool) (*Avatar, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	size := AvatarSize
+	photo := user.PhotoURL
+	if thumb {
+		size = AvatarThumbSize
+		if user.PhotoThumbURL != "" {
+			photo = user.PhotoThumbURL
+		}
+	}
+
+	name := ""
+	switch user.UserType {
+	case "job_seeker":
+		jobSeeker, err := s.jobSeekerRepo.GetByUserID(userID)
+		if err != nil || jobSeeker.TalentVisibility == TalentVisibilityAnonymous {
+			break
+		}
+		name = jobSeeker.FullName
+		if jobSeeker.ProfileVisibility != ProfileVisibilityPrivate && s.fileService.IsStoredFile(photo) {
+			return &Avatar{RedirectURL: photo}, nil
+		}
+	case "employer":
+		employer, err := s.employerRepo.GetByUserID(userID)
+		if err != nil {
+			break
+		}
+		name = employer.CompanyName
+		if s.fileService.IsStoredFile(photo) {
+			return &Avatar{RedirectURL: photo}, nil
+		}
+		if s.fileService.IsStoredFile(employer.LogoURL) {
+			return &Avatar{RedirectURL: employer.LogoURL}, nil
+		}
+	}
+
+	return &Avatar{SVG: utils.InitialsAvatarSVG(name, size)}, nil
+}
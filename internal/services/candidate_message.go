@@ -0,0 +1,123 @@
+package services
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+)
+
+const maxCandidateMessageLength = 2000
+
+// candidateMessagePlaceholder matches a {{.Field}} placeholder, with optional
+// spaces inside the braces
+var candidateMessagePlaceholder = regexp.MustCompile(`\{\{\s*\.(\w+)\s*\}\}`)
+
+// CandidateMessageData is what employers can reference in bulk messages,
+// e.g. "Hi {{.CandidateName}}, thanks for applying to {{.JobTitle}}".
+// Placeholders are substituted as plain text; there is no template logic.
+type CandidateMessageData struct {
+	CandidateName string
+	JobTitle      string
+	CompanyName   string
+	Stage         string
+	Status        string
+}
+
+func (d CandidateMessageData) field(name string) (string, bool) {
+	switch name {
+	case "CandidateName":
+		return d.CandidateName, true
+	case "JobTitle":
+		return d.JobTitle, true
+	case "CompanyName":
+		return d.CompanyName, true
+	case "Stage":
+		return d.Stage, true
+	case "Status":
+		return d.Status, true
+	}
+	return "", false
+}
+
+// candidateMessage is a checked bulk message, ready to render per application
+type candidateMessage struct {
+	text string
+}
+
+// parseCandidateMessage checks the message's placeholders so that unknown
+// ones are rejected before anything is updated.
+func parseCandidateMessage(text string) (*candidateMessage, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+
+	sample := CandidateMessageData{
+		CandidateName: "Candidate",
+		JobTitle:      "Job",
+		CompanyName:   "Company",
+		Stage:         "Stage",
+		Status:        "applied",
+	}
+	if _, err := RenderCandidateMessage(text, sample); err != nil {
+		return nil, errors.New("invalid message template: " + err.Error())
+	}
+
+	return &candidateMessage{text: text}, nil
+}
+
+func renderCandidateMessage(message *candidateMessage, application *models.Application, employer *models.Employer, stages []models.PipelineStage) (string, error) {
+	data := CandidateMessageData{
+		CandidateName: application.JobSeeker.FullName,
+		JobTitle:      application.Job.Title,
+		CompanyName:   employer.CompanyName,
+		Status:        application.Status,
+	}
+	if stage := findStage(stages, application.Stage); stage != nil {
+		data.Stage = stage.Name
+	}
+
+	return RenderCandidateMessage(message.text, data)
+}
+
+// RenderCandidateMessage replaces each {{.Field}} placeholder in text with
+// the matching value. Output is bounded by the text and field lengths, and
+// anything longer than maxCandidateMessageLength is rejected.
+func RenderCandidateMessage(text string, data CandidateMessageData) (string, error) {
+	var unknown string
+	rendered := candidateMessagePlaceholder.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := candidateMessagePlaceholder.FindStringSubmatch(placeholder)[1]
+		value, ok := data.field(name)
+		if !ok && unknown == "" {
+			unknown = name
+		}
+		return value
+	})
+
+	if unknown != "" {
+		return "", errors.New("unknown placeholder {{." + unknown + "}}")
+	}
+	// Check the employer's own text, not the values, which may contain braces
+	if remaining := candidateMessagePlaceholder.ReplaceAllString(text, ""); strings.Contains(remaining, "{{") {
+		return "", errors.New("only {{.Field}} placeholders are supported")
+	}
+	if len(rendered) > maxCandidateMessageLength {
+		return "", errors.New("rendered message is too long")
+	}
+
+	return strings.TrimSpace(rendered), nil
+}
+
+func uniqueIDs(ids []uint) []uint {
+	seen := map[uint]bool{}
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
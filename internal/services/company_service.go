@@ -0,0 +1,237 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+// CompanyProfile is the public view of an employer. Contact details such as
+// the phone number and contact person stay private to the employer.
+type CompanyProfile struct {
+	ID                   uint         `json:"id"`
+	Slug                 string       `json:"slug"`
+	CompanyName          string       `json:"company_name"`
+	EmployerType         string       `json:"employer_type"`
+	Industry             string       `json:"industry"`
+	City                 string       `json:"city"`
+	State                string       `json:"state"`
+	WebsiteURL           string       `json:"website_url"`
+	LogoURL              string       `json:"logo_url"`
+	IsHiring             bool         `json:"is_hiring"`
+	YearFounded          int          `json:"year_founded,omitempty"`
+	FirmSize             string       `json:"firm_size,omitempty"`
+	PrimaryDiscipline    string       `json:"primary_discipline,omitempty"`
+	SecondaryDisciplines []string     `json:"secondary_disciplines"`
+	InstagramURL         string       `json:"instagram_url,omitempty"`
+	LinkedInURL          string       `json:"linkedin_url,omitempty"`
+	PreferredDuration    string       `json:"preferred_duration,omitempty"`
+	StipendRange         string       `json:"stipend_range,omitempty"`
+	Gallery              []string     `json:"gallery"`
+	ActiveJobs           []CompanyJob `json:"active_jobs"`
+}
+
+// CompanyJob summarizes an open job on a company page
+type CompanyJob struct {
+	ID                  uint      `json:"id"`
+	Title               string    `json:"title"`
+	JobType             string    `json:"job_type"`
+	EmploymentMode      string    `json:"employment_mode"`
+	City                string    `json:"city"`
+	State               string    `json:"state"`
+	StartMonth          string    `json:"start_month"`
+	Duration            string    `json:"duration"`
+	IsPaid              bool      `json:"is_paid"`
+	CompensationRange   string    `json:"compensation_range"`
+	ApplicationDeadline time.Time `json:"application_deadline"`
+	RemainingOpenings   int64     `json:"remaining_openings"`
+}
+
+// CompanySummary is a company's entry in the public directory
+type CompanySummary struct {
+	ID                   uint     `json:"id"`
+	Slug                 string   `json:"slug"`
+	CompanyName          string   `json:"company_name"`
+	EmployerType         string   `json:"employer_type"`
+	Industry             string   `json:"industry"`
+	City                 string   `json:"city"`
+	State                string   `json:"state"`
+	LogoURL              string   `json:"logo_url"`
+	IsHiring             bool     `json:"is_hiring"`
+	FirmSize             string   `json:"firm_size,omitempty"`
+	PrimaryDiscipline    string   `json:"primary_discipline,omitempty"`
+	SecondaryDisciplines []string `json:"secondary_disciplines"`
+	ActiveJobCount       int64    `json:"active_job_count"`
+}
+
+type CompanyFilters struct {
+	Query               string `form:"q"`
+	EmployerType        string `form:"employer_type" binding:"omitempty,oneof=firm corporation startup"`
+	Industry            string `form:"industry"`
+	City                string `form:"city"`
+	State               string `form:"state"`
+	PrimaryDiscipline   string `form:"primary_discipline"`
+	SecondaryDiscipline string `form:"secondary_discipline"`
+	FirmSize            string `form:"firm_size"`
+	IsHiring            *bool  `form:"is_hiring"`
+	Page                int    `form:"page" binding:"omitempty,min=1"`
+	PageSize            int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type CompanyService interface {
+	SearchCompanies(filters CompanyFilters) ([]CompanySummary, *Pagination, error)
+	GetCompany(id uint) (*CompanyProfile, error)
+	GetCompanyBySlug(slug string) (*CompanyProfile, error)
+}
+
+type companyService struct {
+	employerRepo repositories.EmployerRepository
+	jobRepo      repositories.JobRepository
+}
+
+func NewCompanyService(employerRepo repositories.EmployerRepository, jobRepo repositories.JobRepository) CompanyService {
+	return &companyService{
+		employerRepo: employerRepo,
+		jobRepo:      jobRepo,
+	}
+}
+
+func (s *companyService) SearchCompanies(filters CompanyFilters) ([]CompanySummary, *Pagination, error) {
+	page := filters.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := filters.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	employers, total, err := s.employerRepo.SearchCompanies(repositories.CompanyFilters{
+		Query:               strings.TrimSpace(filters.Query),
+		EmployerType:        filters.EmployerType,
+		Industry:            strings.TrimSpace(filters.Industry),
+		City:                strings.TrimSpace(filters.City),
+		State:               strings.TrimSpace(filters.State),
+		PrimaryDiscipline:   strings.TrimSpace(filters.PrimaryDiscipline),
+		SecondaryDiscipline: strings.TrimSpace(filters.SecondaryDiscipline),
+		FirmSize:            strings.TrimSpace(filters.FirmSize),
+		IsHiring:            filters.IsHiring,
+		Offset:              (page - 1) * pageSize,
+		Limit:               pageSize,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	companies := make([]CompanySummary, 0, len(employers))
+	for _, employer := range employers {
+		summary := CompanySummary{
+			ID:                   employer.ID,
+			Slug:                 employer.Slug,
+			CompanyName:          employer.CompanyName,
+			EmployerType:         employer.EmployerType,
+			Industry:             employer.Industry,
+			City:                 employer.City,
+			State:                employer.State,
+			LogoURL:              employer.LogoURL,
+			IsHiring:             employer.IsHiring,
+			SecondaryDisciplines: []string{},
+			ActiveJobCount:       employer.ActiveJobCount,
+		}
+		if firm := employer.FirmProfile; firm != nil {
+			summary.FirmSize = firm.FirmSize
+			summary.PrimaryDiscipline = firm.PrimaryDiscipline
+			summary.SecondaryDisciplines = utils.JSONToArray(firm.SecondaryDisciplines)
+		}
+		companies = append(companies, summary)
+	}
+
+	return companies, newPagination(page, pageSize, total), nil
+}
+
+func (s *companyService) GetCompany(id uint) (*CompanyProfile, error) {
+	employer, err := s.employerRepo.GetPublicByID(id)
+	return s.buildProfile(employer, err)
+}
+
+func (s *companyService) GetCompanyBySlug(slug string) (*CompanyProfile, error) {
+	employer, err := s.employerRepo.GetBySlug(slug)
+	return s.buildProfile(employer, err)
+}
+
+func (s *companyService) buildProfile(employer *models.Employer, err error) (*CompanyProfile, error) {
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("company not found")
+		}
+		return nil, err
+	}
+
+	active := true
+	published := false
+	jobs, err := s.jobRepo.GetWithFilters(repositories.JobFilters{
+		EmployerID: employer.ID,
+		IsActive:   &active,
+		IsDraft:    &published,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newCompanyProfile(employer, jobs), nil
+}
+
+func newCompanyProfile(employer *models.Employer, jobs []models.Job) *CompanyProfile {
+	profile := &CompanyProfile{
+		ID:                   employer.ID,
+		Slug:                 employer.Slug,
+		CompanyName:          employer.CompanyName,
+		EmployerType:         employer.EmployerType,
+		Industry:             employer.Industry,
+		City:                 employer.City,
+		State:                employer.State,
+		WebsiteURL:           employer.WebsiteURL,
+		LogoURL:              employer.LogoURL,
+		IsHiring:             employer.IsHiring,
+		SecondaryDisciplines: []string{},
+		Gallery:              []string{},
+		ActiveJobs:           make([]CompanyJob, 0, len(jobs)),
+	}
+
+	if firm := employer.FirmProfile; firm != nil {
+		profile.YearFounded = firm.YearFounded
+		profile.FirmSize = firm.FirmSize
+		profile.PrimaryDiscipline = firm.PrimaryDiscipline
+		profile.SecondaryDisciplines = utils.JSONToArray(firm.SecondaryDisciplines)
+		profile.InstagramURL = firm.InstagramURL
+		profile.LinkedInURL = firm.LinkedInURL
+		profile.PreferredDuration = firm.PreferredDuration
+		profile.StipendRange = firm.StipendRange
+		profile.Gallery = utils.JSONToArray(firm.ProjectImages)
+	}
+
+	for _, job := range jobs {
+		profile.ActiveJobs = append(profile.ActiveJobs, CompanyJob{
+			ID:                  job.ID,
+			Title:               job.Title,
+			JobType:             job.JobType,
+			EmploymentMode:      job.EmploymentMode,
+			City:                job.City,
+			State:               job.State,
+			StartMonth:          job.StartMonth,
+			Duration:            job.Duration,
+			IsPaid:              job.IsPaid,
+			CompensationRange:   job.CompensationRange,
+			ApplicationDeadline: job.ApplicationDeadline,
+			RemainingOpenings:   job.RemainingOpenings,
+		})
+	}
+
+	return profile
+}
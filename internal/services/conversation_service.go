@@ -0,0 +1,197 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+
+	"gorm.io/gorm"
+)
+
+type StartConversationRequest struct {
+	ApplicationID uint `json:"application_id" binding:"required"`
+}
+
+type SendMessageRequest struct {
+	Body string `json:"body" form:"body" binding:"max=5000"`
+}
+
+type ConversationThread struct {
+	Conversation *models.Conversation `json:"conversation"`
+	Messages     []models.Message     `json:"messages"`
+}
+
+type ConversationService interface {
+	StartConversation(userID uint, req StartConversationRequest) (*models.Conversation, error)
+	GetConversations(userID uint) ([]models.Conversation, error)
+	GetThread(userID uint, conversationID uint) (*ConversationThread, error)
+	SendMessage(userID uint, conversationID uint, req SendMessageRequest, attachment *multipart.FileHeader) (*models.Message, error)
+	MarkRead(userID uint, conversationID uint) error
+	GetUnreadCount(userID uint) (int64, error)
+}
+
+type conversationService struct {
+	conversationRepo repositories.ConversationRepository
+	applicationRepo  repositories.ApplicationRepository
+	fileService      FileService
+	notifications    NotificationService
+}
+
+func NewConversationService(
+	conversationRepo repositories.ConversationRepository,
+	applicationRepo repositories.ApplicationRepository,
+	fileService FileService,
+	notifications NotificationService,
+) ConversationService {
+	return &conversationService{
+		conversationRepo: conversationRepo,
+		applicationRepo:  applicationRepo,
+		fileService:      fileService,
+		notifications:    notifications,
+	}
+}
+
+// isParticipant reports whether the user is the applicant or the employer
+// that owns the job the application is for.
+func isParticipant(application *models.Application, userID uint) bool {
+	return application.JobSeeker.UserID == userID || application.Job.Employer.UserID == userID
+}
+
+func (s *conversationService) StartConversation(userID uint, req StartConversationRequest) (*models.Conversation, error) {
+	application, err := s.applicationRepo.GetByID(req.ApplicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if !isParticipant(application, userID) {
+		return nil, errors.New("unauthorized to message about this application")
+	}
+
+	// Each application has a single thread, reuse it if it already exists
+	conversation, err := s.conversationRepo.GetByApplicationID(application.ID)
+	if err == nil {
+		return conversation, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	conversation = &models.Conversation{ApplicationID: application.ID}
+	if err := s.conversationRepo.Create(conversation); err != nil {
+		return nil, errors.New("failed to start conversation")
+	}
+
+	return conversation, nil
+}
+
+func (s *conversationService) GetConversations(userID uint) ([]models.Conversation, error) {
+	return s.conversationRepo.GetForUser(userID)
+}
+
+func (s *conversationService) getAuthorized(userID uint, conversationID uint) (*models.Conversation, error) {
+	conversation, err := s.conversationRepo.GetByID(conversationID)
+	if err != nil {
+		return nil, errors.New("conversation not found")
+	}
+
+	if !isParticipant(&conversation.Application, userID) {
+		return nil, errors.New("unauthorized to access this conversation")
+	}
+
+	return conversation, nil
+}
+
+// GetThread returns the messages of a conversation and marks the ones sent
+// to the user as read.
+func (s *conversationService) GetThread(userID uint, conversationID uint) (*ConversationThread, error) {
+	conversation, err := s.getAuthorized(userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.conversationRepo.MarkRead(conversationID, userID); err != nil {
+		return nil, err
+	}
+
+	messages, err := s.conversationRepo.GetMessages(conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConversationThread{
+		Conversation: conversation,
+		Messages:     messages,
+	}, nil
+}
+
+func (s *conversationService) SendMessage(userID uint, conversationID uint, req SendMessageRequest, attachment *multipart.FileHeader) (*models.Message, error) {
+	conversation, err := s.getAuthorized(userID, conversationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if conversation.Application.DeletedAt.Valid {
+		return nil, errors.New("the application has been withdrawn")
+	}
+
+	body := strings.TrimSpace(req.Body)
+	if body == "" && attachment == nil {
+		return nil, errors.New("message must have a body or an attachment")
+	}
+
+	message := &models.Message{
+		ConversationID: conversationID,
+		SenderUserID:   userID,
+		Body:           body,
+		CreatedAt:      time.Now(),
+	}
+
+	if attachment != nil {
+		url, err := s.fileService.UploadMessageAttachment(userID, attachment)
+		if err != nil {
+			return nil, err
+		}
+		message.AttachmentURL = url
+		message.AttachmentName = filepath.Base(attachment.Filename)
+	}
+
+	if err := s.conversationRepo.CreateMessage(message); err != nil {
+		return nil, errors.New("failed to send message")
+	}
+
+	// Let the other side of the thread know a message arrived
+	application := conversation.Application
+	recipientID := application.Job.Employer.UserID
+	if userID == recipientID {
+		recipientID = application.JobSeeker.UserID
+	}
+	s.notifications.Notify(&models.Notification{
+		UserID:         recipientID,
+		Type:           NotificationNewMessage,
+		Title:          "New message",
+		Body:           fmt.Sprintf("New message about %s", application.Job.Title),
+		JobID:          &application.JobID,
+		ApplicationID:  &application.ID,
+		ConversationID: &conversation.ID,
+	})
+
+	return message, nil
+}
+
+func (s *conversationService) MarkRead(userID uint, conversationID uint) error {
+	if _, err := s.getAuthorized(userID, conversationID); err != nil {
+		return err
+	}
+
+	return s.conversationRepo.MarkRead(conversationID, userID)
+}
+
+func (s *conversationService) GetUnreadCount(userID uint) (int64, error) {
+	return s.conversationRepo.CountUnread(userID)
+}
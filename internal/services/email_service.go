@@ -0,0 +1,169 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+)
+
+const (
+	maxEmailAttempts = 6
+	emailBatchSize   = 50
+)
+
+type WelcomeEmailData struct {
+	UserType string
+	AppURL   string
+}
+
+type ApplicationEmailData struct {
+	CandidateName  string
+	JobTitle       string
+	CompanyName    string
+	Stage          string
+	Note           string
+	ApplicationURL string
+}
+
+type JobExpiredEmailData struct {
+	ContactName string
+	JobTitle    string
+	Deadline    time.Time
+	JobURL      string
+}
+
+// EmailService queues transactional emails in the outbox and delivers them
+// in the background, retrying failed sends with backoff.
+type EmailService interface {
+	Enqueue(userID uint, template string, data interface{})
+	AppURL(path string) string
+	ProcessOutbox() (sent int, failed int, err error)
+	Start(interval time.Duration)
+}
+
+type emailService struct {
+	outboxRepo repositories.EmailOutboxRepository
+	userRepo   repositories.UserRepository
+	mailer     Mailer
+	appURL     string
+}
+
+func NewEmailService(
+	outboxRepo repositories.EmailOutboxRepository,
+	userRepo repositories.UserRepository,
+	mailer Mailer,
+	appURL string,
+) EmailService {
+	return &emailService{
+		outboxRepo: outboxRepo,
+		userRepo:   userRepo,
+		mailer:     mailer,
+		appURL:     strings.TrimRight(appURL, "/"),
+	}
+}
+
+// AppURL builds a link into the frontend for use in email templates
+func (s *emailService) AppURL(path string) string {
+	return s.appURL + path
+}
+
+// Enqueue renders the template in the user's language and stores it in the
+// outbox. Like notifications, emails are a side effect of other actions, so
+// failures are logged rather than returned.
+func (s *emailService) Enqueue(userID uint, template string, data interface{}) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		log.Printf("Failed to queue %s email for user %d: %v", template, userID, err)
+		return
+	}
+
+	rendered, err := RenderEmail(user.Locale, template, data)
+	if err != nil {
+		log.Printf("Failed to render %s email for user %d: %v", template, userID, err)
+		return
+	}
+
+	email := &models.OutboxEmail{
+		ToEmail:       user.Email,
+		Template:      template,
+		Locale:        rendered.Locale,
+		Subject:       rendered.Subject,
+		HTMLBody:      rendered.HTML,
+		TextBody:      rendered.Text,
+		Status:        models.EmailStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+	if err := s.outboxRepo.Create(email); err != nil {
+		log.Printf("Failed to queue %s email for user %d: %v", template, userID, err)
+	}
+}
+
+// EmailRetryDelay is how long to wait before retrying an email that has
+// failed the given number of times: 1, 2, 4, 8... minutes, capped at an hour.
+func EmailRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := time.Minute << uint(attempts-1)
+	if delay > time.Hour || delay <= 0 {
+		return time.Hour
+	}
+	return delay
+}
+
+func (s *emailService) ProcessOutbox() (int, int, error) {
+	emails, err := s.outboxRepo.GetDue(emailBatchSize)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sent, failed := 0, 0
+	for i := range emails {
+		email := &emails[i]
+		email.Attempts++
+
+		if err := s.mailer.Send(email); err != nil {
+			email.LastError = err.Error()
+			if email.Attempts >= maxEmailAttempts {
+				email.Status = models.EmailStatusFailed
+			} else {
+				email.NextAttemptAt = time.Now().Add(EmailRetryDelay(email.Attempts))
+			}
+			failed++
+		} else {
+			now := time.Now()
+			email.Status = models.EmailStatusSent
+			email.SentAt = &now
+			email.LastError = ""
+			sent++
+		}
+
+		if err := s.outboxRepo.Update(email); err != nil {
+			return sent, failed, fmt.Errorf("failed to update outbox email %d: %w", email.ID, err)
+		}
+	}
+
+	return sent, failed, nil
+}
+
+// Start delivers due outbox emails in the background on the given interval
+func (s *emailService) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			sent, failed, err := s.ProcessOutbox()
+			if err != nil {
+				log.Println("Email outbox run failed:", err)
+			} else if sent > 0 || failed > 0 {
+				log.Printf("📧 Email outbox sent %d, failed %d", sent, failed)
+			}
+			<-ticker.C
+		}
+	}()
+}
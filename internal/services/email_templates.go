@@ -0,0 +1,99 @@
+package services
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Each email has a <locale>/<name>.txt file defining the "subject" and
+// plain-text "body" templates, and a <locale>/<name>.html file defining the
+// "content" rendered inside the locale's layout.html.
+//
+//go:embed email_templates
+var emailTemplateFS embed.FS
+
+const defaultEmailLocale = "en"
+
+// Email templates
+const (
+	EmailWelcome                  = "welcome"
+	EmailApplicationSubmitted     = "application_submitted"
+	EmailApplicationStatusChanged = "application_status_changed"
+	EmailJobExpired               = "job_expired"
+)
+
+type RenderedEmail struct {
+	Locale  string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// resolveEmailLocale picks the closest locale the template exists in:
+// the exact locale ("pt-br"), then its language ("pt"), then the default.
+func resolveEmailLocale(locale string, name string) (string, error) {
+	locale = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+
+	candidates := []string{}
+	if locale != "" {
+		candidates = append(candidates, locale)
+		if language, _, found := strings.Cut(locale, "-"); found {
+			candidates = append(candidates, language)
+		}
+	}
+	candidates = append(candidates, defaultEmailLocale)
+
+	for _, candidate := range candidates {
+		if _, err := fs.Stat(emailTemplateFS, path.Join("email_templates", candidate, name+".txt")); err == nil {
+			return candidate, nil
+		}
+	}
+
+	return "", errors.New("unknown email template: " + name)
+}
+
+// RenderEmail renders the subject, HTML and plain-text bodies of an email in
+// the requested locale, falling back to English when no translation exists.
+func RenderEmail(locale string, name string, data interface{}) (*RenderedEmail, error) {
+	resolved, err := resolveEmailLocale(locale, name)
+	if err != nil {
+		return nil, err
+	}
+	dir := path.Join("email_templates", resolved)
+
+	text, err := texttemplate.ParseFS(emailTemplateFS, path.Join(dir, name+".txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	var subject, body bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := text.ExecuteTemplate(&body, "body", data); err != nil {
+		return nil, err
+	}
+
+	html, err := htmltemplate.ParseFS(emailTemplateFS, path.Join(dir, "layout.html"), path.Join(dir, name+".html"))
+	if err != nil {
+		return nil, err
+	}
+
+	var htmlBody bytes.Buffer
+	if err := html.ExecuteTemplate(&htmlBody, "layout", data); err != nil {
+		return nil, err
+	}
+
+	return &RenderedEmail{
+		Locale:  resolved,
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    htmlBody.String(),
+		Text:    strings.TrimSpace(body.String()) + "\n",
+	}, nil
+}
@@ -2,9 +2,11 @@ package services
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
 
 	"gorm.io/gorm"
 )
@@ -20,7 +22,7 @@ type CreateEmployerRequest struct {
 	State              string `json:"state" binding:"required"`
 	PinCode            string `json:"pin_code" binding:"required,len=6"`
 	WebsiteURL         string `json:"website_url" binding:"required,url"`
-	LogoURL            string `json:"logo_url"`
+	LogoURL            string `json:"logo_url" binding:"omitempty,url"`
 }
 
 type UpdateEmployerRequest struct {
@@ -34,7 +36,7 @@ type UpdateEmployerRequest struct {
 	State              string `json:"state"`
 	PinCode            string `json:"pin_code" binding:"omitempty,len=6"`
 	WebsiteURL         string `json:"website_url" binding:"omitempty,url"`
-	LogoURL            string `json:"logo_url"`
+	LogoURL            string `json:"logo_url" binding:"omitempty,url"`
 	IsHiring           *bool  `json:"is_hiring"`
 }
 
@@ -72,8 +74,14 @@ func (s *employerService) CreateProfile(userID uint, req CreateEmployerRequest)
 		return nil, errors.New("employer profile already exists")
 	}
 
+	slug, err := s.uniqueSlug(req.CompanyName)
+	if err != nil {
+		return nil, errors.New("failed to create employer profile")
+	}
+
 	employer := &models.Employer{
 		UserID:             userID,
+		Slug:               slug,
 		CompanyName:        req.CompanyName,
 		EmployerType:       req.EmployerType,
 		Industry:           req.Industry,
@@ -95,6 +103,28 @@ func (s *employerService) CreateProfile(userID uint, req CreateEmployerRequest)
 	return employer, nil
 }
 
+// uniqueSlug derives the company's public URL slug, numbering it when
+// another company already uses the name. Slugs don't change on rename so
+// shared links keep working.
+func (s *employerService) uniqueSlug(companyName string) (string, error) {
+	base := utils.Slugify(companyName)
+	if base == "" {
+		base = "company"
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		taken, err := s.employerRepo.SlugExists(slug)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
 func (s *employerService) GetProfile(userID uint) (*models.Employer, error) {
 	employer, err := s.employerRepo.GetByUserID(userID)
 	if err != nil {
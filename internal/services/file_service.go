@@ -1,29 +1,63 @@
 package services
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png" // registers the PNG decoder for avatars
 	"mime/multipart"
 	"path/filepath"
 	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
 )
 
 type FileType string
 
 const (
-	FileTypeResume    FileType = "resume"
-	FileTypePortfolio FileType = "portfolio"
+	FileTypeResume            FileType = "resume"
+	FileTypePortfolio         FileType = "portfolio"
+	FileTypeMessageAttachment FileType = "message_attachment"
+	FileTypeOfferDocument     FileType = "offer_document"
+	FileTypePortfolioMedia    FileType = "portfolio_media"
+	FileTypeAvatar            FileType = "avatar"
+)
+
+const (
+	// AvatarSize and AvatarThumbSize are the square sizes, in pixels, that
+	// uploaded photos are cropped to
+	AvatarSize      = 256
+	AvatarThumbSize = 64
+
+	minAvatarDimension = AvatarThumbSize
+	maxAvatarPixels    = 40_000_000 // guards against decompression bombs
 )
 
+// UploadedAvatar holds the URLs of a cropped profile photo
+type UploadedAvatar struct {
+	URL      string
+	ThumbURL string
+}
+
 type StorageService interface {
 	UploadFile(userID uint, fileType FileType, file *multipart.FileHeader) (string, error)
+	UploadBytes(userID uint, fileType FileType, name string, data []byte) (string, error)
 	DeleteFile(url string) error
+	OwnsURL(url string) bool // whether url points into this storage
 	GetFileURL(userID uint, fileType FileType, filename string) string
 }
 
 type FileService interface {
 	UploadResume(userID uint, file *multipart.FileHeader) (string, error)
 	UploadPortfolio(userID uint, file *multipart.FileHeader) (string, error)
+	UploadMessageAttachment(userID uint, file *multipart.FileHeader) (string, error)
+	UploadOfferDocument(userID uint, file *multipart.FileHeader) (string, error)
+	UploadPortfolioMedia(userID uint, file *multipart.FileHeader) (string, error)
+	UploadAvatar(userID uint, file *multipart.FileHeader) (*UploadedAvatar, error)
 	ValidateFile(file *multipart.FileHeader, allowedTypes []string, maxSize int64) error
+	IsStoredFile(url string) bool
 }
 
 type fileService struct {
@@ -52,6 +86,95 @@ func (s *fileService) UploadPortfolio(userID uint, file *multipart.FileHeader) (
 	return s.storage.UploadFile(userID, FileTypePortfolio, file)
 }
 
+func (s *fileService) UploadMessageAttachment(userID uint, file *multipart.FileHeader) (string, error) {
+	if err := s.ValidateFile(file, []string{".pdf", ".docx", ".png", ".jpg", ".jpeg"}, 10*1024*1024); err != nil {
+		return "", err
+	}
+	
+	return s.storage.UploadFile(userID, FileTypeMessageAttachment, file)
+}
+
+func (s *fileService) UploadOfferDocument(userID uint, file *multipart.FileHeader) (string, error) {
+	if err := s.ValidateFile(file, []string{".pdf"}, 10*1024*1024); err != nil {
+		return "", err
+	}
+
+	return s.storage.UploadFile(userID, FileTypeOfferDocument, file)
+}
+
+func (s *fileService) UploadPortfolioMedia(userID uint, file *multipart.FileHeader) (string, error) {
+	if err := s.ValidateFile(file, []string{".png", ".jpg", ".jpeg", ".webp", ".pdf"}, 15*1024*1024); err != nil {
+		return "", err
+	}
+
+	return s.storage.UploadFile(userID, FileTypePortfolioMedia, file)
+}
+
+// UploadAvatar checks that the file is a real PNG or JPEG photo, crops it to
+// a centred square and stores it at the standard avatar sizes as JPEG
+func (s *fileService) UploadAvatar(userID uint, file *multipart.FileHeader) (*UploadedAvatar, error) {
+	if err := s.ValidateFile(file, []string{".png", ".jpg", ".jpeg"}, 5*1024*1024); err != nil {
+		return nil, err
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return nil, errors.New("failed to read image")
+	}
+	defer src.Close()
+
+	var data bytes.Buffer
+	if _, err := data.ReadFrom(src); err != nil {
+		return nil, errors.New("failed to read image")
+	}
+
+	// Check the dimensions before decoding so huge images are never loaded
+	config, _, err := image.DecodeConfig(bytes.NewReader(data.Bytes()))
+	if err != nil {
+		return nil, errors.New("file is not a valid PNG or JPEG image")
+	}
+	if config.Width < minAvatarDimension || config.Height < minAvatarDimension {
+		return nil, fmt.Errorf("image must be at least %dx%d pixels", minAvatarDimension, minAvatarDimension)
+	}
+	if config.Width*config.Height > maxAvatarPixels {
+		return nil, errors.New("image dimensions are too large")
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data.Bytes()))
+	if err != nil {
+		return nil, errors.New("file is not a valid PNG or JPEG image")
+	}
+
+	avatar := &UploadedAvatar{}
+	for _, target := range []struct {
+		size int
+		url  *string
+	}{
+		{AvatarSize, &avatar.URL},
+		{AvatarThumbSize, &avatar.ThumbURL},
+	} {
+		var encoded bytes.Buffer
+		if err := jpeg.Encode(&encoded, utils.CropSquare(img, target.size), &jpeg.Options{Quality: 90}); err != nil {
+			return nil, errors.New("failed to process image")
+		}
+
+		name := fmt.Sprintf("%dpx.jpg", target.size)
+		url, err := s.storage.UploadBytes(userID, FileTypeAvatar, name, encoded.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		*target.url = url
+	}
+
+	return avatar, nil
+}
+
+// IsStoredFile reports whether url is a file uploaded to our storage, as
+// opposed to a link someone typed in
+func (s *fileService) IsStoredFile(url string) bool {
+	return s.storage.OwnsURL(url)
+}
+
 func (s *fileService) ValidateFile(file *multipart.FileHeader, allowedTypes []string, maxSize int64) error {
 	if file.Size > maxSize {
 		return fmt.Errorf("file size %d bytes exceeds maximum %d bytes", file.Size, maxSize)
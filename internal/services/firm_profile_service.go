@@ -41,6 +41,7 @@ type FirmProfileService interface {
 	GetProfile(employerID uint) (*models.FirmProfile, error)
 	UpdateProfile(employerID uint, req UpdateFirmProfileRequest) (*models.FirmProfile, error)
 	DeleteProfile(employerID uint) error
+	RestoreProfile(employerID uint) (*models.FirmProfile, error)
 }
 
 type firmProfileService struct {
@@ -175,4 +176,22 @@ func (s *firmProfileService) DeleteProfile(employerID uint) error {
 	}
 
 	return s.firmProfileRepo.Delete(employerID)
+}
+
+func (s *firmProfileService) RestoreProfile(employerID uint) (*models.FirmProfile, error) {
+	existingProfile, err := s.firmProfileRepo.GetByEmployerID(employerID)
+	if err == nil && existingProfile != nil {
+		return nil, errors.New("firm profile already exists")
+	}
+
+	deletedProfile, err := s.firmProfileRepo.GetDeletedByEmployerID(employerID)
+	if err != nil {
+		return nil, errors.New("deleted firm profile not found")
+	}
+
+	if err := s.firmProfileRepo.Restore(deletedProfile.ID); err != nil {
+		return nil, errors.New("failed to restore firm profile")
+	}
+
+	return s.firmProfileRepo.GetByEmployerID(employerID)
 }
\ No newline at end of file
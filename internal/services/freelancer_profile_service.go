@@ -0,0 +1,166 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+type CreateFreelancerProfileRequest struct {
+	HourlyRate   int      `json:"hourly_rate" binding:"min=0,max=100000"`
+	ProjectRate  string   `json:"project_rate" binding:"max=100"`
+	Availability string   `json:"availability" binding:"omitempty,oneof=available limited unavailable"` // defaults to available
+	HoursPerWeek int      `json:"hours_per_week" binding:"min=0,max=80"`
+	Services     []string `json:"services" binding:"omitempty,max=20"`
+	Clients      []string `json:"clients" binding:"omitempty,max=50"`
+}
+
+type UpdateFreelancerProfileRequest struct {
+	HourlyRate   *int     `json:"hourly_rate" binding:"omitempty,min=0,max=100000"`
+	ProjectRate  string   `json:"project_rate" binding:"max=100"`
+	Availability string   `json:"availability" binding:"omitempty,oneof=available limited unavailable"`
+	HoursPerWeek *int     `json:"hours_per_week" binding:"omitempty,min=0,max=80"`
+	Services     []string `json:"services" binding:"omitempty,max=20"`
+	Clients      []string `json:"clients" binding:"omitempty,max=50"`
+}
+
+type FreelancerProfileService interface {
+	CreateProfile(jobSeekerID uint, req CreateFreelancerProfileRequest) (*models.FreelancerProfile, error)
+	GetProfile(jobSeekerID uint) (*models.FreelancerProfile, error)
+	UpdateProfile(jobSeekerID uint, req UpdateFreelancerProfileRequest) (*models.FreelancerProfile, error)
+	DeleteProfile(jobSeekerID uint) error
+	RestoreProfile(jobSeekerID uint) (*models.FreelancerProfile, error)
+}
+
+type freelancerProfileService struct {
+	freelancerProfileRepo repositories.FreelancerProfileRepository
+	jobSeekerRepo         repositories.JobSeekerRepository
+}
+
+func NewFreelancerProfileService(
+	freelancerProfileRepo repositories.FreelancerProfileRepository,
+	jobSeekerRepo repositories.JobSeekerRepository,
+) FreelancerProfileService {
+	return &freelancerProfileService{
+		freelancerProfileRepo: freelancerProfileRepo,
+		jobSeekerRepo:         jobSeekerRepo,
+	}
+}
+
+func (s *freelancerProfileService) CreateProfile(jobSeekerID uint, req CreateFreelancerProfileRequest) (*models.FreelancerProfile, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	if jobSeeker.JobSeekerType != "freelancer" {
+		return nil, errors.New("freelancer profile can only be created for freelancer job seekers")
+	}
+
+	existingProfile, err := s.freelancerProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err == nil && existingProfile != nil {
+		return nil, errors.New("freelancer profile already exists")
+	}
+
+	availability := req.Availability
+	if availability == "" {
+		availability = models.AvailabilityAvailable
+	}
+
+	profile := &models.FreelancerProfile{
+		JobSeekerID:  jobSeekerID,
+		HourlyRate:   req.HourlyRate,
+		ProjectRate:  strings.TrimSpace(req.ProjectRate),
+		Availability: availability,
+		HoursPerWeek: req.HoursPerWeek,
+		Services:     utils.ArrayToJSON(req.Services),
+		Clients:      utils.ArrayToJSON(req.Clients),
+	}
+
+	if err := s.freelancerProfileRepo.Create(profile); err != nil {
+		return nil, errors.New("failed to create freelancer profile")
+	}
+
+	profile.JobSeeker = *jobSeeker
+	return profile, nil
+}
+
+func (s *freelancerProfileService) GetProfile(jobSeekerID uint) (*models.FreelancerProfile, error) {
+	_, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	profile, err := s.freelancerProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("freelancer profile not found")
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (s *freelancerProfileService) UpdateProfile(jobSeekerID uint, req UpdateFreelancerProfileRequest) (*models.FreelancerProfile, error) {
+	profile, err := s.freelancerProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("freelancer profile not found")
+	}
+
+	if req.HourlyRate != nil {
+		profile.HourlyRate = *req.HourlyRate
+	}
+	if req.ProjectRate != "" {
+		profile.ProjectRate = strings.TrimSpace(req.ProjectRate)
+	}
+	if req.Availability != "" {
+		profile.Availability = req.Availability
+	}
+	if req.HoursPerWeek != nil {
+		profile.HoursPerWeek = *req.HoursPerWeek
+	}
+	if len(req.Services) > 0 {
+		profile.Services = utils.ArrayToJSON(req.Services)
+	}
+	if len(req.Clients) > 0 {
+		profile.Clients = utils.ArrayToJSON(req.Clients)
+	}
+
+	if err := s.freelancerProfileRepo.Update(profile); err != nil {
+		return nil, errors.New("failed to update freelancer profile")
+	}
+
+	return profile, nil
+}
+
+func (s *freelancerProfileService) DeleteProfile(jobSeekerID uint) error {
+	_, err := s.freelancerProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return errors.New("freelancer profile not found")
+	}
+
+	return s.freelancerProfileRepo.Delete(jobSeekerID)
+}
+
+func (s *freelancerProfileService) RestoreProfile(jobSeekerID uint) (*models.FreelancerProfile, error) {
+	existingProfile, err := s.freelancerProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err == nil && existingProfile != nil {
+		return nil, errors.New("freelancer profile already exists")
+	}
+
+	deletedProfile, err := s.freelancerProfileRepo.GetDeletedByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("deleted freelancer profile not found")
+	}
+
+	if err := s.freelancerProfileRepo.Restore(deletedProfile.ID); err != nil {
+		return nil, errors.New("failed to restore freelancer profile")
+	}
+
+	return s.freelancerProfileRepo.GetByJobSeekerID(jobSeekerID)
+}
@@ -0,0 +1,494 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+const interviewTimeFormat = "Mon, Jan 2 2006 at 15:04 MST"
+
+type ProposeInterviewRequest struct {
+	Mode            string      `json:"mode" binding:"required,oneof=online on_site"`
+	MeetingURL      string      `json:"meeting_url" binding:"omitempty,url,max=500"`
+	Location        string      `json:"location" binding:"max=500"`
+	Timezone        string      `json:"timezone" binding:"required"`
+	DurationMinutes int         `json:"duration_minutes" binding:"required,min=15,max=480"`
+	Notes           string      `json:"notes" binding:"max=2000"`
+	Slots           []time.Time `json:"slots" binding:"required,min=1,max=10"`
+}
+
+type RescheduleInterviewRequest struct {
+	Slots  []time.Time `json:"slots" binding:"required,min=1,max=10"`
+	Reason string      `json:"reason" binding:"max=1000"`
+}
+
+type SelectInterviewSlotRequest struct {
+	SlotID uint `json:"slot_id" binding:"required"`
+}
+
+type CancelInterviewRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+type InterviewService interface {
+	ProposeInterview(userID uint, applicationID uint, req ProposeInterviewRequest) (*models.Interview, error)
+	GetInterviews(userID uint) ([]models.Interview, error)
+	GetApplicationInterviews(userID uint, applicationID uint) ([]models.Interview, error)
+	GetInterview(userID uint, interviewID uint) (*models.Interview, error)
+	SelectSlot(userID uint, interviewID uint, req SelectInterviewSlotRequest) (*models.Interview, error)
+	RescheduleInterview(userID uint, interviewID uint, req RescheduleInterviewRequest) (*models.Interview, error)
+	CancelInterview(userID uint, interviewID uint, req CancelInterviewRequest) (*models.Interview, error)
+	GetCalendar(userID uint, interviewID uint) (string, error)
+	CreateCalendarToken(userID uint) (string, error)
+	GetCalendarFeed(token string) (string, error)
+	SendReminders() (int, error)
+	Start(interval time.Duration)
+}
+
+type interviewService struct {
+	interviewRepo   repositories.InterviewRepository
+	applicationRepo repositories.ApplicationRepository
+	userRepo        repositories.UserRepository
+	notifications   NotificationService
+	reminderWindow  time.Duration
+}
+
+func NewInterviewService(
+	interviewRepo repositories.InterviewRepository,
+	applicationRepo repositories.ApplicationRepository,
+	userRepo repositories.UserRepository,
+	notifications NotificationService,
+	reminderWindow time.Duration,
+) InterviewService {
+	return &interviewService{
+		interviewRepo:   interviewRepo,
+		applicationRepo: applicationRepo,
+		userRepo:        userRepo,
+		notifications:   notifications,
+		reminderWindow:  reminderWindow,
+	}
+}
+
+// buildInterviewSlots checks the proposed start times and returns them as
+// slots in chronological order.
+func buildInterviewSlots(times []time.Time) ([]models.InterviewSlot, error) {
+	now := time.Now()
+	seen := map[int64]bool{}
+	slots := make([]models.InterviewSlot, 0, len(times))
+
+	for _, startsAt := range times {
+		if !startsAt.After(now) {
+			return nil, errors.New("interview slots must be in the future")
+		}
+		if seen[startsAt.Unix()] {
+			return nil, errors.New("interview slots must be different times")
+		}
+		seen[startsAt.Unix()] = true
+		slots = append(slots, models.InterviewSlot{StartsAt: startsAt.UTC()})
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].StartsAt.Before(slots[j].StartsAt)
+	})
+	return slots, nil
+}
+
+// localizeInterview fills in the human-readable times in the interview's timezone
+func localizeInterview(interview *models.Interview) {
+	location, err := time.LoadLocation(interview.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	if interview.ScheduledAt != nil {
+		interview.LocalScheduledAt = interview.ScheduledAt.In(location).Format(interviewTimeFormat)
+	}
+	for i := range interview.Slots {
+		interview.Slots[i].LocalStartsAt = interview.Slots[i].StartsAt.In(location).Format(interviewTimeFormat)
+	}
+}
+
+func isInterviewEmployer(interview *models.Interview, userID uint) bool {
+	return interview.Application.Job.Employer.UserID == userID
+}
+
+func isInterviewCandidate(interview *models.Interview, userID uint) bool {
+	return interview.Application.JobSeeker.UserID == userID
+}
+
+func (s *interviewService) ProposeInterview(userID uint, applicationID uint, req ProposeInterviewRequest) (*models.Interview, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if application.Job.Employer.UserID != userID {
+		return nil, errors.New("unauthorized to schedule interviews for this application")
+	}
+
+	if application.Status == "rejected" {
+		return nil, errors.New("cannot interview a rejected candidate")
+	}
+
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return nil, errors.New("invalid timezone")
+	}
+
+	meetingURL := strings.TrimSpace(req.MeetingURL)
+	location := strings.TrimSpace(req.Location)
+	if req.Mode == "online" && meetingURL == "" {
+		return nil, errors.New("meeting URL is required for online interviews")
+	}
+	if req.Mode == "on_site" && location == "" {
+		return nil, errors.New("address is required for on-site interviews")
+	}
+
+	slots, err := buildInterviewSlots(req.Slots)
+	if err != nil {
+		return nil, err
+	}
+
+	interview := &models.Interview{
+		ApplicationID:   application.ID,
+		Status:          models.InterviewStatusProposed,
+		Mode:            req.Mode,
+		MeetingURL:      meetingURL,
+		Location:        location,
+		Timezone:        req.Timezone,
+		DurationMinutes: req.DurationMinutes,
+		Notes:           strings.TrimSpace(req.Notes),
+		CreatedByUserID: userID,
+		Slots:           slots,
+	}
+	if err := s.interviewRepo.Create(interview); err != nil {
+		return nil, errors.New("failed to create interview")
+	}
+
+	interview.Application = *application
+	s.notifyInterview(interview, application.JobSeeker.UserID, NotificationInterviewProposed, "Interview invitation",
+		fmt.Sprintf("%s invited you to interview for %s. Pick a time that suits you.", application.Job.Employer.CompanyName, application.Job.Title))
+
+	localizeInterview(interview)
+	return interview, nil
+}
+
+func (s *interviewService) GetInterviews(userID uint) ([]models.Interview, error) {
+	interviews, err := s.interviewRepo.GetForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range interviews {
+		localizeInterview(&interviews[i])
+	}
+	return interviews, nil
+}
+
+func (s *interviewService) GetApplicationInterviews(userID uint, applicationID uint) ([]models.Interview, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if !isParticipant(application, userID) {
+		return nil, errors.New("unauthorized to view interviews for this application")
+	}
+
+	interviews, err := s.interviewRepo.GetByApplicationID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range interviews {
+		localizeInterview(&interviews[i])
+	}
+	return interviews, nil
+}
+
+func (s *interviewService) getAuthorized(userID uint, interviewID uint) (*models.Interview, error) {
+	interview, err := s.interviewRepo.GetByID(interviewID)
+	if err != nil {
+		return nil, errors.New("interview not found")
+	}
+
+	if !isParticipant(&interview.Application, userID) {
+		return nil, errors.New("unauthorized to access this interview")
+	}
+
+	return interview, nil
+}
+
+func (s *interviewService) GetInterview(userID uint, interviewID uint) (*models.Interview, error) {
+	interview, err := s.getAuthorized(userID, interviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	localizeInterview(interview)
+	return interview, nil
+}
+
+func (s *interviewService) SelectSlot(userID uint, interviewID uint, req SelectInterviewSlotRequest) (*models.Interview, error) {
+	interview, err := s.getAuthorized(userID, interviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isInterviewCandidate(interview, userID) {
+		return nil, errors.New("only the candidate can pick an interview slot")
+	}
+
+	if interview.Status != models.InterviewStatusProposed {
+		return nil, errors.New("interview is not awaiting a time")
+	}
+
+	var slot *models.InterviewSlot
+	for i := range interview.Slots {
+		if interview.Slots[i].ID == req.SlotID {
+			slot = &interview.Slots[i]
+		}
+	}
+	if slot == nil {
+		return nil, errors.New("slot not found")
+	}
+	if !slot.StartsAt.After(time.Now()) {
+		return nil, errors.New("this slot has already passed")
+	}
+
+	scheduledAt := slot.StartsAt
+	interview.Status = models.InterviewStatusScheduled
+	interview.SelectedSlotID = &slot.ID
+	interview.ScheduledAt = &scheduledAt
+	interview.Sequence++
+	if err := s.interviewRepo.Update(interview); err != nil {
+		return nil, errors.New("failed to schedule interview")
+	}
+
+	localizeInterview(interview)
+	s.notifyInterview(interview, interview.Application.Job.Employer.UserID, NotificationInterviewScheduled, "Interview scheduled",
+		fmt.Sprintf("%s will interview for %s on %s", interview.Application.JobSeeker.FullName, interview.Application.Job.Title, interview.LocalScheduledAt))
+
+	return interview, nil
+}
+
+func (s *interviewService) RescheduleInterview(userID uint, interviewID uint, req RescheduleInterviewRequest) (*models.Interview, error) {
+	interview, err := s.getAuthorized(userID, interviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isInterviewEmployer(interview, userID) {
+		return nil, errors.New("only the employer can reschedule an interview")
+	}
+
+	if interview.Status == models.InterviewStatusCancelled {
+		return nil, errors.New("interview has been cancelled")
+	}
+
+	slots, err := buildInterviewSlots(req.Slots)
+	if err != nil {
+		return nil, err
+	}
+
+	// The candidate picks again from the new slots
+	interview.Status = models.InterviewStatusProposed
+	interview.SelectedSlotID = nil
+	interview.ScheduledAt = nil
+	interview.ReminderSentAt = nil
+	interview.Sequence++
+	if err := s.interviewRepo.ReplaceSlots(interview, slots); err != nil {
+		return nil, errors.New("failed to reschedule interview")
+	}
+
+	body := fmt.Sprintf("%s proposed new times for your %s interview.", interview.Application.Job.Employer.CompanyName, interview.Application.Job.Title)
+	if reason := strings.TrimSpace(req.Reason); reason != "" {
+		body += " " + reason
+	}
+	s.notifyInterview(interview, interview.Application.JobSeeker.UserID, NotificationInterviewProposed, "Interview rescheduled", body)
+
+	localizeInterview(interview)
+	return interview, nil
+}
+
+func (s *interviewService) CancelInterview(userID uint, interviewID uint, req CancelInterviewRequest) (*models.Interview, error) {
+	interview, err := s.getAuthorized(userID, interviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	if interview.Status == models.InterviewStatusCancelled {
+		return nil, errors.New("interview is already cancelled")
+	}
+
+	interview.Status = models.InterviewStatusCancelled
+	interview.CancelReason = strings.TrimSpace(req.Reason)
+	interview.CancelledByUserID = &userID
+	interview.Sequence++
+	if err := s.interviewRepo.Update(interview); err != nil {
+		return nil, errors.New("failed to cancel interview")
+	}
+
+	// Tell whoever didn't cancel
+	recipientID := interview.Application.JobSeeker.UserID
+	if isInterviewCandidate(interview, userID) {
+		recipientID = interview.Application.Job.Employer.UserID
+	}
+	body := fmt.Sprintf("The interview for %s has been cancelled.", interview.Application.Job.Title)
+	if interview.CancelReason != "" {
+		body += " " + interview.CancelReason
+	}
+	s.notifyInterview(interview, recipientID, NotificationInterviewCancelled, "Interview cancelled", body)
+
+	localizeInterview(interview)
+	return interview, nil
+}
+
+func (s *interviewService) notifyInterview(interview *models.Interview, recipientID uint, notificationType string, title string, body string) {
+	s.notifications.Notify(&models.Notification{
+		UserID:        recipientID,
+		Type:          notificationType,
+		Title:         title,
+		Body:          body,
+		JobID:         &interview.Application.JobID,
+		ApplicationID: &interview.ApplicationID,
+	})
+}
+
+// interviewEvent describes a scheduled interview as a calendar event from
+// the point of view of the given user.
+func interviewEvent(interview *models.Interview, userID uint) utils.CalendarEvent {
+	job := interview.Application.Job
+	summary := fmt.Sprintf("Interview: %s at %s", job.Title, job.Employer.CompanyName)
+	if isInterviewEmployer(interview, userID) {
+		summary = fmt.Sprintf("Interview with %s: %s", interview.Application.JobSeeker.FullName, job.Title)
+	}
+
+	var description []string
+	if interview.MeetingURL != "" {
+		description = append(description, "Join: "+interview.MeetingURL)
+	}
+	if interview.Notes != "" {
+		description = append(description, interview.Notes)
+	}
+
+	location := interview.Location
+	if interview.Mode == "online" {
+		location = interview.MeetingURL
+	}
+
+	return utils.CalendarEvent{
+		UID:         fmt.Sprintf("interview-%d@blackpages", interview.ID),
+		Sequence:    interview.Sequence,
+		Start:       *interview.ScheduledAt,
+		End:         interview.ScheduledAt.Add(time.Duration(interview.DurationMinutes) * time.Minute),
+		Summary:     summary,
+		Description: strings.Join(description, "\n\n"),
+		Location:    location,
+		URL:         interview.MeetingURL,
+		Cancelled:   interview.Status == models.InterviewStatusCancelled,
+		UpdatedAt:   interview.UpdatedAt,
+	}
+}
+
+func (s *interviewService) GetCalendar(userID uint, interviewID uint) (string, error) {
+	interview, err := s.getAuthorized(userID, interviewID)
+	if err != nil {
+		return "", err
+	}
+
+	if interview.ScheduledAt == nil {
+		return "", errors.New("interview has not been scheduled yet")
+	}
+
+	return utils.BuildICS("", []utils.CalendarEvent{interviewEvent(interview, userID)}), nil
+}
+
+// CreateCalendarToken issues a new secret for the user's calendar feed,
+// replacing any earlier one so old subscription links stop working.
+func (s *interviewService) CreateCalendarToken(userID uint) (string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.New("failed to create calendar link")
+	}
+	token := hex.EncodeToString(raw)
+
+	if err := s.userRepo.SetCalendarToken(userID, token); err != nil {
+		return "", errors.New("failed to create calendar link")
+	}
+
+	return token, nil
+}
+
+// GetCalendarFeed returns every interview of the token's owner that has a
+// time. Cancelled ones stay in the feed so subscribed calendars remove them.
+func (s *interviewService) GetCalendarFeed(token string) (string, error) {
+	user, err := s.userRepo.GetByCalendarToken(token)
+	if err != nil {
+		return "", errors.New("calendar not found")
+	}
+
+	interviews, err := s.interviewRepo.GetForUser(user.ID)
+	if err != nil {
+		return "", err
+	}
+
+	events := []utils.CalendarEvent{}
+	for i := range interviews {
+		if interviews[i].ScheduledAt != nil {
+			events = append(events, interviewEvent(&interviews[i], user.ID))
+		}
+	}
+
+	return utils.BuildICS("Black Pages interviews", events), nil
+}
+
+// SendReminders notifies both sides of interviews starting within the reminder window
+func (s *interviewService) SendReminders() (int, error) {
+	interviews, err := s.interviewRepo.GetDueReminders(time.Now().Add(s.reminderWindow))
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range interviews {
+		interview := &interviews[i]
+		if err := s.interviewRepo.MarkReminderSent(interview.ID); err != nil {
+			return i, err
+		}
+
+		localizeInterview(interview)
+		job := interview.Application.Job
+		s.notifyInterview(interview, interview.Application.JobSeeker.UserID, NotificationInterviewReminder, "Upcoming interview",
+			fmt.Sprintf("Your interview for %s at %s is on %s", job.Title, job.Employer.CompanyName, interview.LocalScheduledAt))
+		s.notifyInterview(interview, job.Employer.UserID, NotificationInterviewReminder, "Upcoming interview",
+			fmt.Sprintf("Your interview with %s for %s is on %s", interview.Application.JobSeeker.FullName, job.Title, interview.LocalScheduledAt))
+	}
+
+	return len(interviews), nil
+}
+
+// Start sends interview reminders in the background on the given interval
+func (s *interviewService) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if sent, err := s.SendReminders(); err != nil {
+				log.Println("Interview reminder run failed:", err)
+			} else if sent > 0 {
+				log.Printf("🔔 Sent reminders for %d interviews", sent)
+			}
+			<-ticker.C
+		}
+	}()
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+)
+
+const (
+	// MaxInvitationsPerDay is how many candidates an employer can invite in
+	// any 24 hour window
+	MaxInvitationsPerDay = 25
+
+	defaultInvitationExpiryDays = 14
+)
+
+var ErrInvitationLimitReached = fmt.Errorf("invitation limit reached: employers can send up to %d invitations per day", MaxInvitationsPerDay)
+
+type CreateInvitationRequest struct {
+	JobID         uint   `json:"job_id" binding:"required"`
+	JobSeekerID   uint   `json:"job_seeker_id" binding:"required"`
+	Message       string `json:"message" binding:"max=2000"`
+	ExpiresInDays int    `json:"expires_in_days" binding:"omitempty,min=1,max=30"` // defaults to 14
+}
+
+// AcceptInvitationRequest carries what a normal application would: the
+// cover letter and answers to the job's screening questions
+type AcceptInvitationRequest struct {
+	CoverLetter         string                 `json:"cover_letter" binding:"max=5000"`
+	Answers             []ScreeningAnswerInput `json:"answers" binding:"omitempty,dive"`
+	PortfolioProjectIDs []uint                 `json:"portfolio_project_ids" binding:"omitempty,max=10,unique"`
+}
+
+type DeclineInvitationRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+type InvitationFilters struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending accepted declined expired"`
+}
+
+type SentInvitationFilters struct {
+	JobID uint `form:"job_id"`
+}
+
+type InvitationService interface {
+	CreateInvitation(employerID uint, userID uint, req CreateInvitationRequest) (*models.Invitation, error)
+	GetSentInvitations(employerID uint, filters SentInvitationFilters) ([]models.Invitation, error)
+	GetMyInvitations(jobSeekerID uint, filters InvitationFilters) ([]models.Invitation, error)
+	GetMyInvitation(jobSeekerID uint, invitationID uint) (*models.Invitation, error)
+	AcceptInvitation(jobSeekerID uint, invitationID uint, req AcceptInvitationRequest) (*models.Invitation, error)
+	DeclineInvitation(jobSeekerID uint, invitationID uint, req DeclineInvitationRequest) (*models.Invitation, error)
+}
+
+type invitationService struct {
+	invitationRepo     repositories.InvitationRepository
+	jobRepo            repositories.JobRepository
+	jobSeekerRepo      repositories.JobSeekerRepository
+	applicationRepo    repositories.ApplicationRepository
+	applicationService ApplicationService
+	notifications      NotificationService
+}
+
+func NewInvitationService(
+	invitationRepo repositories.InvitationRepository,
+	jobRepo repositories.JobRepository,
+	jobSeekerRepo repositories.JobSeekerRepository,
+	applicationRepo repositories.ApplicationRepository,
+	applicationService ApplicationService,
+	notifications NotificationService,
+) InvitationService {
+	return &invitationService{
+		invitationRepo:     invitationRepo,
+		jobRepo:            jobRepo,
+		jobSeekerRepo:      jobSeekerRepo,
+		applicationRepo:    applicationRepo,
+		applicationService: applicationService,
+		notifications:      notifications,
+	}
+}
+
+func (s *invitationService) CreateInvitation(employerID uint, userID uint, req CreateInvitationRequest) (*models.Invitation, error) {
+	job, err := s.jobRepo.GetByID(req.JobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to invite candidates to this job")
+	}
+
+	if job.IsDraft || !job.IsActive {
+		return nil, errors.New("candidates can only be invited to active jobs")
+	}
+
+	if job.ApplicationDeadline.Before(time.Now()) {
+		return nil, errors.New("application deadline has passed")
+	}
+
+	// Only candidates listed in talent search can be invited
+	jobSeeker, err := s.jobSeekerRepo.GetByID(req.JobSeekerID)
+	if err != nil || !jobSeeker.OpenToOpportunities {
+		return nil, errors.New("candidate not found")
+	}
+
+	if existing, err := s.applicationRepo.GetByJobAndJobSeeker(job.ID, jobSeeker.ID); err == nil && existing != nil {
+		return nil, errors.New("candidate has already applied to this job")
+	}
+
+	if err := s.invitationRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+	// A candidate who declined isn't asked about the same job again
+	invited, err := s.invitationRepo.HasInvitation(job.ID, jobSeeker.ID, []string{models.InvitationStatusPending, models.InvitationStatusDeclined})
+	if err != nil {
+		return nil, err
+	}
+	if invited {
+		return nil, errors.New("candidate has already been invited to this job")
+	}
+
+	sent, err := s.invitationRepo.CountSentSince(employerID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if sent >= MaxInvitationsPerDay {
+		return nil, ErrInvitationLimitReached
+	}
+
+	days := req.ExpiresInDays
+	if days == 0 {
+		days = defaultInvitationExpiryDays
+	}
+	expiresAt := time.Now().AddDate(0, 0, days)
+	// There's no point accepting after applications close
+	if job.ApplicationDeadline.Before(expiresAt) {
+		expiresAt = job.ApplicationDeadline
+	}
+
+	invitation := &models.Invitation{
+		JobID:           job.ID,
+		JobSeekerID:     jobSeeker.ID,
+		EmployerID:      employerID,
+		InvitedByUserID: userID,
+		Status:          models.InvitationStatusPending,
+		Message:         strings.TrimSpace(req.Message),
+		ExpiresAt:       expiresAt,
+	}
+	if err := s.invitationRepo.Create(invitation); err != nil {
+		return nil, errors.New("failed to send invitation")
+	}
+
+	s.notifications.Notify(&models.Notification{
+		UserID: jobSeeker.UserID,
+		Type:   NotificationInvitationReceived,
+		Title:  "You're invited to apply",
+		Body:   fmt.Sprintf("%s invited you to apply for %s", job.Employer.CompanyName, job.Title),
+		JobID:  &job.ID,
+	})
+
+	invitation.Job = *job
+	return invitation, nil
+}
+
+func (s *invitationService) GetSentInvitations(employerID uint, filters SentInvitationFilters) ([]models.Invitation, error) {
+	if err := s.invitationRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+
+	return s.invitationRepo.GetByEmployerID(employerID, filters.JobID)
+}
+
+func (s *invitationService) GetMyInvitations(jobSeekerID uint, filters InvitationFilters) ([]models.Invitation, error) {
+	if err := s.invitationRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+
+	return s.invitationRepo.GetByJobSeekerID(jobSeekerID, filters.Status)
+}
+
+func (s *invitationService) GetMyInvitation(jobSeekerID uint, invitationID uint) (*models.Invitation, error) {
+	if err := s.invitationRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.invitationRepo.GetByID(invitationID)
+	if err != nil || invitation.JobSeekerID != jobSeekerID {
+		return nil, errors.New("invitation not found")
+	}
+
+	// The job's screening questions are answered when accepting
+	hideKnockoutRules(invitation.Job.ScreeningQuestions)
+	return invitation, nil
+}
+
+// getPending loads one of the candidate's invitations that can still be responded to
+func (s *invitationService) getPending(jobSeekerID uint, invitationID uint) (*models.Invitation, error) {
+	invitation, err := s.GetMyInvitation(jobSeekerID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.Status != models.InvitationStatusPending {
+		return nil, fmt.Errorf("invitation is already %s", invitation.Status)
+	}
+
+	return invitation, nil
+}
+
+func (s *invitationService) AcceptInvitation(jobSeekerID uint, invitationID uint, req AcceptInvitationRequest) (*models.Invitation, error) {
+	invitation, err := s.getPending(jobSeekerID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accepting goes through the normal application checks; if any fail the
+	// invitation stays open so the candidate can fix their profile and retry
+	application, err := s.applicationService.ApplyToJob(jobSeekerID, ApplyJobRequest{
+		JobID:               invitation.JobID,
+		CoverLetter:         req.CoverLetter,
+		Answers:             req.Answers,
+		PortfolioProjectIDs: req.PortfolioProjectIDs,
+		Source:              models.ApplicationSourceInvited,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation.Status = models.InvitationStatusAccepted
+	invitation.RespondedAt = &now
+	invitation.ApplicationID = &application.ID
+	if err := s.invitationRepo.Update(invitation); err != nil {
+		return nil, errors.New("failed to accept invitation")
+	}
+
+	return invitation, nil
+}
+
+func (s *invitationService) DeclineInvitation(jobSeekerID uint, invitationID uint, req DeclineInvitationRequest) (*models.Invitation, error) {
+	invitation, err := s.getPending(jobSeekerID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation.Status = models.InvitationStatusDeclined
+	invitation.RespondedAt = &now
+	invitation.DeclineReason = strings.TrimSpace(req.Reason)
+	if err := s.invitationRepo.Update(invitation); err != nil {
+		return nil, errors.New("failed to decline invitation")
+	}
+
+	// Anonymous candidates stay anonymous when declining
+	candidate := "A candidate"
+	if invitation.JobSeeker != nil && invitation.JobSeeker.TalentVisibility != TalentVisibilityAnonymous {
+		candidate = invitation.JobSeeker.FullName
+	}
+	s.notifications.Notify(&models.Notification{
+		UserID: invitation.Job.Employer.UserID,
+		Type:   NotificationInvitationDeclined,
+		Title:  "Invitation declined",
+		Body:   fmt.Sprintf("%s declined your invitation to apply for %s", candidate, invitation.Job.Title),
+		JobID:  &invitation.JobID,
+	})
+
+	return invitation, nil
+}
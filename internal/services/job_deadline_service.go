@@ -0,0 +1,111 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+)
+
+// JobDeadlineService warns employers when one of their jobs is about to stop
+// accepting applications, and emails them once the deadline has passed.
+type JobDeadlineService interface {
+	NotifyClosingSoon() (int, error)
+	NotifyExpired() (int, error)
+	Start(interval time.Duration)
+}
+
+type jobDeadlineService struct {
+	window        time.Duration
+	jobRepo       repositories.JobRepository
+	notifications NotificationService
+	emails        EmailService
+}
+
+func NewJobDeadlineService(
+	window time.Duration,
+	jobRepo repositories.JobRepository,
+	notifications NotificationService,
+	emails EmailService,
+) JobDeadlineService {
+	return &jobDeadlineService{
+		window:        window,
+		jobRepo:       jobRepo,
+		notifications: notifications,
+		emails:        emails,
+	}
+}
+
+func (s *jobDeadlineService) NotifyClosingSoon() (int, error) {
+	jobs, err := s.jobRepo.GetClosingSoon(time.Now().Add(s.window))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range jobs {
+		job := &jobs[i]
+		// Mark first so a failing notification can't repeat every run
+		if err := s.jobRepo.MarkClosingNoticeSent(job.ID); err != nil {
+			return sent, err
+		}
+
+		s.notifications.Notify(&models.Notification{
+			UserID: job.Employer.UserID,
+			Type:   NotificationJobClosingSoon,
+			Title:  "Job closing soon",
+			Body:   fmt.Sprintf("Applications for %s close on %s", job.Title, job.ApplicationDeadline.Format("Jan 2, 2006 15:04 MST")),
+			JobID:  &job.ID,
+		})
+		sent++
+	}
+
+	return sent, nil
+}
+
+func (s *jobDeadlineService) NotifyExpired() (int, error) {
+	jobs, err := s.jobRepo.GetExpiredUnnotified()
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		if err := s.jobRepo.MarkExpiryNoticeSent(job.ID); err != nil {
+			return i, err
+		}
+
+		s.emails.Enqueue(job.Employer.UserID, EmailJobExpired, JobExpiredEmailData{
+			ContactName: job.Employer.ContactPerson,
+			JobTitle:    job.Title,
+			Deadline:    job.ApplicationDeadline,
+			JobURL:      s.emails.AppURL(fmt.Sprintf("/employers/jobs/%d", job.ID)),
+		})
+	}
+
+	return len(jobs), nil
+}
+
+// Start checks for closing and expired jobs in the background on the given interval
+func (s *jobDeadlineService) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if sent, err := s.NotifyClosingSoon(); err != nil {
+				log.Println("Closing soon check failed:", err)
+			} else if sent > 0 {
+				log.Printf("🔔 Sent %d job closing soon notifications", sent)
+			}
+			if expired, err := s.NotifyExpired(); err != nil {
+				log.Println("Job expiry check failed:", err)
+			} else if expired > 0 {
+				log.Printf("📧 Queued %d job expiry emails", expired)
+			}
+			<-ticker.C
+		}
+	}()
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+)
+
+// Job revision actions
+const (
+	JobActionCreated      = "created"
+	JobActionDraftCreated = "draft_created"
+	JobActionCloned       = "cloned"
+	JobActionUpdated      = "updated"
+	JobActionPublished    = "published"
+	JobActionActivated    = "activated"
+	JobActionDeactivated  = "deactivated"
+	JobActionDeleted      = "deleted"
+	JobActionRestored     = "restored"
+	JobActionFilled       = "filled"
+)
+
+// materialJobFields are the fields applicants are told about when they change
+// after an application has been submitted.
+var materialJobFields = map[string]bool{
+	"title":                true,
+	"job_type":             true,
+	"employment_mode":      true,
+	"start_month":          true,
+	"duration":             true,
+	"application_deadline": true,
+	"compensation_range":   true,
+	"is_paid":              true,
+	"city":                 true,
+	"state":                true,
+	"required_skills":      true,
+	"min_experience":       true,
+	"portfolio_required":   true,
+	"resume_required":      true,
+}
+
+type fieldChange struct {
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// jobSnapshot flattens the editable fields of a job into strings so that two
+// versions can be compared field by field.
+func jobSnapshot(job *models.Job) map[string]string {
+	return map[string]string{
+		"title":                job.Title,
+		"job_type":             job.JobType,
+		"industry":             job.Industry,
+		"target_audience":      job.TargetAudience,
+		"employment_mode":      job.EmploymentMode,
+		"start_month":          job.StartMonth,
+		"duration":             job.Duration,
+		"application_deadline": job.ApplicationDeadline.UTC().Format(time.RFC3339),
+		"compensation_range":   job.CompensationRange,
+		"is_paid":              strconv.FormatBool(job.IsPaid),
+		"city":                 job.City,
+		"state":                job.State,
+		"required_skills":      job.RequiredSkills,
+		"min_experience":       job.MinExperience,
+		"portfolio_required":   strconv.FormatBool(job.PortfolioRequired),
+		"resume_required":      strconv.FormatBool(job.ResumeRequired),
+		"description":          job.Description,
+		"about_team":           job.AboutTeam,
+		"contact_email":        job.ContactEmail,
+		"is_active":            strconv.FormatBool(job.IsActive),
+		"is_draft":             strconv.FormatBool(job.IsDraft),
+		"openings":             strconv.Itoa(job.Openings),
+		"screening_questions":  screeningQuestionsSummary(job.ScreeningQuestions),
+	}
+}
+
+// screeningQuestionsSummary serializes the questions without their IDs so
+// that re-saving an identical set doesn't register as a change.
+func screeningQuestionsSummary(questions []models.ScreeningQuestion) string {
+	type questionSummary struct {
+		Prompt          string   `json:"prompt"`
+		QuestionType    string   `json:"question_type"`
+		Options         string   `json:"options,omitempty"`
+		IsRequired      bool     `json:"is_required"`
+		IsKnockout      bool     `json:"is_knockout"`
+		AcceptedAnswers string   `json:"accepted_answers,omitempty"`
+		MinValue        *float64 `json:"min_value,omitempty"`
+		MaxValue        *float64 `json:"max_value,omitempty"`
+	}
+
+	summaries := make([]questionSummary, 0, len(questions))
+	for _, question := range questions {
+		summaries = append(summaries, questionSummary{
+			Prompt:          question.Prompt,
+			QuestionType:    question.QuestionType,
+			Options:         question.Options,
+			IsRequired:      question.IsRequired,
+			IsKnockout:      question.IsKnockout,
+			AcceptedAnswers: question.AcceptedAnswers,
+			MinValue:        question.MinValue,
+			MaxValue:        question.MaxValue,
+		})
+	}
+
+	summary, _ := json.Marshal(summaries)
+	return string(summary)
+}
+
+func diffJobSnapshots(before, after map[string]string) map[string]fieldChange {
+	changes := map[string]fieldChange{}
+	for field, newValue := range after {
+		if oldValue := before[field]; oldValue != newValue {
+			changes[field] = fieldChange{Old: oldValue, New: newValue}
+		}
+	}
+	return changes
+}
+
+func newJobRevision(action string, actorUserID uint, snapshot map[string]string, changes map[string]fieldChange) *models.JobRevision {
+	snapshotJSON, _ := json.Marshal(snapshot)
+	changesJSON, _ := json.Marshal(changes)
+
+	return &models.JobRevision{
+		Action:          action,
+		ChangedByUserID: actorUserID,
+		Changes:         string(changesJSON),
+		Snapshot:        string(snapshotJSON),
+	}
+}
+
+// summarizeMaterialChanges collapses a chronological list of revisions into
+// one entry per material field, dropping fields that were changed back.
+func summarizeMaterialChanges(revisions []models.JobRevision) []models.JobFieldChange {
+	summary := map[string]*models.JobFieldChange{}
+	for _, revision := range revisions {
+		var changes map[string]fieldChange
+		if err := json.Unmarshal([]byte(revision.Changes), &changes); err != nil {
+			continue
+		}
+
+		for field, change := range changes {
+			if !materialJobFields[field] {
+				continue
+			}
+			if existing, ok := summary[field]; ok {
+				existing.NewValue = change.New
+				existing.ChangedAt = revision.CreatedAt
+				continue
+			}
+			summary[field] = &models.JobFieldChange{
+				Field:     field,
+				OldValue:  change.Old,
+				NewValue:  change.New,
+				ChangedAt: revision.CreatedAt,
+			}
+		}
+	}
+
+	result := []models.JobFieldChange{}
+	for _, change := range summary {
+		if change.OldValue != change.NewValue {
+			result = append(result, *change)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ChangedAt.Before(result[j].ChangedAt)
+	})
+	return result
+}
@@ -29,6 +29,9 @@ type UpdateJobSeekerRequest struct {
 	ResumeURL      string   `json:"resume_url"`
 	PortfolioURL   string   `json:"portfolio_url"`
 	Skills         []string `json:"skills"`
+	// Talent search listing; phone and email are never shown to employers
+	OpenToOpportunities *bool  `json:"open_to_opportunities"`
+	TalentVisibility    string `json:"talent_visibility" binding:"omitempty,oneof=full anonymous"`
 }
 
 type JobSeekerService interface {
@@ -36,6 +39,7 @@ type JobSeekerService interface {
 	GetProfile(userID uint) (*models.JobSeeker, error)
 	UpdateProfile(userID uint, req UpdateJobSeekerRequest) (*models.JobSeeker, error)
 	GetProfileWithExtensions(userID uint) (*models.JobSeeker, error)
+	GetProfileCompleteness(userID uint) (*ProfileCompleteness, error)
 }
 
 type jobSeekerService struct {
@@ -140,6 +144,12 @@ func (s *jobSeekerService) UpdateProfile(userID uint, req UpdateJobSeekerRequest
 		skillsJSON += `"]`
 		jobSeeker.Skills = skillsJSON
 	}
+	if req.OpenToOpportunities != nil {
+		jobSeeker.OpenToOpportunities = *req.OpenToOpportunities
+	}
+	if req.TalentVisibility != "" {
+		jobSeeker.TalentVisibility = req.TalentVisibility
+	}
 
 	if err := s.jobSeekerRepo.Update(jobSeeker); err != nil {
 		return nil, errors.New("failed to update job seeker profile")
@@ -149,5 +159,15 @@ func (s *jobSeekerService) UpdateProfile(userID uint, req UpdateJobSeekerRequest
 }
 
 func (s *jobSeekerService) GetProfileWithExtensions(userID uint) (*models.JobSeeker, error) {
-	return s.jobSeekerRepo.GetWithStudentProfile(userID)
-}
\ No newline at end of file
+	return s.jobSeekerRepo.GetWithExtensions(userID)
+}
+
+func (s *jobSeekerService) GetProfileCompleteness(userID uint) (*ProfileCompleteness, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetWithExtensions(userID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	completeness := ComputeProfileCompleteness(jobSeeker)
+	return &completeness, nil
+}
@@ -2,11 +2,16 @@ package services
 
 import (
 	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
 
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	"gorm.io/gorm"
 )
 
@@ -30,6 +35,8 @@ type CreateJobRequest struct {
 	Description         string    `json:"description" binding:"required"`
 	AboutTeam           string    `json:"about_team"`
 	ContactEmail        string    `json:"contact_email" binding:"required,email"`
+	Openings            int       `json:"openings" binding:"omitempty,min=1,max=1000"` // defaults to 1
+	ScreeningQuestions  []ScreeningQuestionInput `json:"screening_questions" binding:"omitempty,max=20,dive"`
 }
 
 type UpdateJobRequest struct {
@@ -53,6 +60,50 @@ type UpdateJobRequest struct {
 	AboutTeam           string    `json:"about_team"`
 	ContactEmail        string    `json:"contact_email" binding:"omitempty,email"`
 	IsActive            *bool     `json:"is_active"`
+	Openings            *int      `json:"openings" binding:"omitempty,min=1,max=1000"`
+	// nil leaves the questions untouched, an empty list removes them
+	ScreeningQuestions  []ScreeningQuestionInput `json:"screening_questions" binding:"omitempty,max=20,dive"`
+}
+
+// SaveJobDraftRequest mirrors CreateJobRequest with relaxed validation so that
+// incomplete postings can be saved and finished later.
+type SaveJobDraftRequest struct {
+	Title               string     `json:"title" binding:"required"`
+	JobType             string     `json:"job_type" binding:"omitempty,oneof=internship full_time contract"`
+	Industry            string     `json:"industry"`
+	TargetAudience      string     `json:"target_audience" binding:"omitempty,oneof=students professionals any"`
+	EmploymentMode      string     `json:"employment_mode" binding:"omitempty,oneof=on_site remote hybrid"`
+	StartMonth          string     `json:"start_month"`
+	Duration            string     `json:"duration"`
+	ApplicationDeadline *time.Time `json:"application_deadline"`
+	CompensationRange   string     `json:"compensation_range"`
+	IsPaid              bool       `json:"is_paid"`
+	City                string     `json:"city"`
+	State               string     `json:"state"`
+	RequiredSkills      []string   `json:"required_skills"`
+	MinExperience       string     `json:"min_experience"`
+	PortfolioRequired   bool       `json:"portfolio_required"`
+	ResumeRequired      bool       `json:"resume_required"`
+	Description         string     `json:"description"`
+	AboutTeam           string     `json:"about_team"`
+	ContactEmail        string     `json:"contact_email" binding:"omitempty,email"`
+	Openings            int        `json:"openings" binding:"omitempty,min=1,max=1000"`
+	ScreeningQuestions  []ScreeningQuestionInput `json:"screening_questions" binding:"omitempty,max=20,dive"`
+}
+
+type CloneJobRequest struct {
+	Title               string     `json:"title"`
+	StartMonth          string     `json:"start_month"`
+	Duration            string     `json:"duration"`
+	ApplicationDeadline *time.Time `json:"application_deadline"`
+	Openings            int        `json:"openings" binding:"omitempty,min=1,max=1000"`
+	Publish             bool       `json:"publish"`
+}
+
+type JobPreview struct {
+	Job            *models.Job `json:"job"`
+	ReadyToPublish bool        `json:"ready_to_publish"`
+	Issues         []string    `json:"issues"`
 }
 
 type JobFilters struct {
@@ -63,6 +114,7 @@ type JobFilters struct {
 	EmploymentMode  string `form:"employment_mode"`
 	IsPaid          *bool  `form:"is_paid"`
 	IsActive        *bool  `form:"is_active"`
+	IsDraft         *bool  `form:"is_draft"`
 	OrderBy         string `form:"order_by"`
 	OrderDirection  string `form:"order_direction"`
 	Limit           int    `form:"limit"`
@@ -78,7 +130,7 @@ type FilterOptions struct {
 
 type JobService interface {
 	CreateJob(employerID uint, req CreateJobRequest) (*models.Job, error)
-	GetJob(id uint) (*models.Job, error)
+	GetEmployerJob(employerID uint, jobID uint) (*models.Job, error)
 	UpdateJob(employerID uint, jobID uint, req UpdateJobRequest) (*models.Job, error)
 	DeleteJob(employerID uint, jobID uint) error
 	GetEmployerJobs(employerID uint, filters JobFilters) ([]models.Job, error)
@@ -86,17 +138,39 @@ type JobService interface {
 	ToggleJobStatus(employerID uint, jobID uint) (*models.Job, error)
 	GetEmployerDashboardStats(employerID uint) (map[string]interface{}, error)
 	GetFilterOptions() (*FilterOptions, error)
+	GetPublishedJob(id uint) (*models.Job, error)
+	SaveDraft(employerID uint, req SaveJobDraftRequest) (*models.Job, error)
+	PreviewJob(employerID uint, jobID uint) (*JobPreview, error)
+	PublishJob(employerID uint, jobID uint) (*models.Job, error)
+	CloneJob(employerID uint, jobID uint, req CloneJobRequest) (*models.Job, error)
+	GetJobHistory(employerID uint, jobID uint) ([]models.JobRevision, error)
+	GetDeletedJobs(employerID uint) ([]models.Job, error)
+	RestoreJob(employerID uint, jobID uint) (*models.Job, error)
+	GetPipeline(employerID uint, jobID uint) ([]models.PipelineStage, error)
+	UpdatePipeline(employerID uint, jobID uint, req UpdatePipelineRequest) ([]models.PipelineStage, error)
 }
 
 type jobService struct {
-	jobRepo      repositories.JobRepository
-	employerRepo repositories.EmployerRepository
+	jobRepo         repositories.JobRepository
+	employerRepo    repositories.EmployerRepository
+	jobRevisionRepo repositories.JobRevisionRepository
+	pipelineRepo    repositories.PipelineStageRepository
+	offerRepo       repositories.OfferRepository
 }
 
-func NewJobService(jobRepo repositories.JobRepository, employerRepo repositories.EmployerRepository) JobService {
+func NewJobService(
+	jobRepo repositories.JobRepository,
+	employerRepo repositories.EmployerRepository,
+	jobRevisionRepo repositories.JobRevisionRepository,
+	pipelineRepo repositories.PipelineStageRepository,
+	offerRepo repositories.OfferRepository,
+) JobService {
 	return &jobService{
-		jobRepo:      jobRepo,
-		employerRepo: employerRepo,
+		jobRepo:         jobRepo,
+		employerRepo:    employerRepo,
+		jobRevisionRepo: jobRevisionRepo,
+		pipelineRepo:    pipelineRepo,
+		offerRepo:       offerRepo,
 	}
 }
 
@@ -106,8 +180,13 @@ func (s *jobService) CreateJob(employerID uint, req CreateJobRequest) (*models.J
 		return nil, errors.New("employer not found")
 	}
 
-	if req.ApplicationDeadline.Before(time.Now()) {
-		return nil, errors.New("application deadline cannot be in the past")
+	if err := validateJobForPublish(req); err != nil {
+		return nil, err
+	}
+
+	questions, err := buildScreeningQuestions(req.ScreeningQuestions)
+	if err != nil {
+		return nil, err
 	}
 
 	skillsJSON := ""
@@ -141,9 +220,13 @@ func (s *jobService) CreateJob(employerID uint, req CreateJobRequest) (*models.J
 		AboutTeam:           req.AboutTeam,
 		ContactEmail:        req.ContactEmail,
 		IsActive:            true,
+		Openings:            max(req.Openings, 1),
+		ScreeningQuestions:  questions,
 	}
+	job.RemainingOpenings = int64(job.Openings)
 
-	if err := s.jobRepo.Create(job); err != nil {
+	revision := newJobRevision(JobActionCreated, employer.UserID, jobSnapshot(job), nil)
+	if err := s.jobRepo.CreateWithRevision(job, revision); err != nil {
 		return nil, errors.New("failed to create job")
 	}
 
@@ -151,7 +234,10 @@ func (s *jobService) CreateJob(employerID uint, req CreateJobRequest) (*models.J
 	return job, nil
 }
 
-func (s *jobService) GetJob(id uint) (*models.Job, error) {
+// getJob loads a job with its screening questions and knockout rules. Only
+// the owning employer may see the rules, so callers either check ownership or
+// hide them before returning the job.
+func (s *jobService) getJob(id uint) (*models.Job, error) {
 	job, err := s.jobRepo.GetByID(id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -162,6 +248,21 @@ func (s *jobService) GetJob(id uint) (*models.Job, error) {
 	return job, nil
 }
 
+// GetEmployerJob returns one of the employer's own jobs, drafts and knockout
+// rules included. Other employers' jobs look the same as ones that don't exist.
+func (s *jobService) GetEmployerJob(employerID uint, jobID uint) (*models.Job, error) {
+	job, err := s.getJob(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("job not found")
+	}
+
+	return job, nil
+}
+
 func (s *jobService) UpdateJob(employerID uint, jobID uint, req UpdateJobRequest) (*models.Job, error) {
 	job, err := s.jobRepo.GetByID(jobID)
 	if err != nil {
@@ -172,6 +273,8 @@ func (s *jobService) UpdateJob(employerID uint, jobID uint, req UpdateJobRequest
 		return nil, errors.New("unauthorized to update this job")
 	}
 
+	before := jobSnapshot(job)
+
 	if req.Title != "" {
 		job.Title = req.Title
 	}
@@ -197,6 +300,11 @@ func (s *jobService) UpdateJob(employerID uint, jobID uint, req UpdateJobRequest
 		if req.ApplicationDeadline.Before(time.Now()) {
 			return nil, errors.New("application deadline cannot be in the past")
 		}
+		if !job.ApplicationDeadline.Equal(*req.ApplicationDeadline) {
+			// A new deadline gets its own "closing soon" and expiry notices
+			job.ClosingNoticeAt = nil
+			job.ExpiryNoticeAt = nil
+		}
 		job.ApplicationDeadline = *req.ApplicationDeadline
 	}
 	if req.CompensationRange != "" {
@@ -237,11 +345,59 @@ func (s *jobService) UpdateJob(employerID uint, jobID uint, req UpdateJobRequest
 	if req.ContactEmail != "" {
 		job.ContactEmail = req.ContactEmail
 	}
+	if req.Openings != nil {
+		if int64(*req.Openings) < job.FilledOpenings {
+			return nil, fmt.Errorf("openings cannot be fewer than the %d candidates already selected", job.FilledOpenings)
+		}
+		job.Openings = *req.Openings
+		job.RemainingOpenings = int64(job.Openings) - job.FilledOpenings
+	}
 	if req.IsActive != nil {
+		if job.IsDraft && *req.IsActive {
+			return nil, errors.New("draft jobs must be published before they can be activated")
+		}
+		if *req.IsActive && !job.IsActive && job.RemainingOpenings == 0 {
+			return nil, errors.New("every opening is filled; add openings before reactivating this job")
+		}
 		job.IsActive = *req.IsActive
 	}
 
-	if err := s.jobRepo.Update(job); err != nil {
+	var questions []models.ScreeningQuestion
+	if req.ScreeningQuestions != nil {
+		// Answers already submitted refer to the existing questions
+		hasApplications, err := s.jobRepo.HasApplications(job.ID)
+		if err != nil {
+			return nil, errors.New("failed to update job")
+		}
+		if hasApplications {
+			return nil, errors.New("screening questions cannot be changed once candidates have applied")
+		}
+
+		questions, err = buildScreeningQuestions(req.ScreeningQuestions)
+		if err != nil {
+			return nil, err
+		}
+		job.ScreeningQuestions = questions
+	}
+
+	after := jobSnapshot(job)
+	changes := diffJobSnapshots(before, after)
+	if len(changes) == 0 {
+		return job, nil
+	}
+
+	revision := newJobRevision(JobActionUpdated, job.Employer.UserID, after, changes)
+	if req.ScreeningQuestions != nil {
+		err = s.jobRepo.ReplaceScreeningQuestions(job, questions, revision)
+	} else {
+		err = s.jobRepo.UpdateWithRevision(job, revision)
+	}
+	if err != nil {
+		return nil, errors.New("failed to update job")
+	}
+
+	// Lowering the openings to the number already selected fills the job
+	if _, err := closeJobIfFilled(s.jobRepo, job, job.Employer.UserID); err != nil {
 		return nil, errors.New("failed to update job")
 	}
 
@@ -258,7 +414,35 @@ func (s *jobService) DeleteJob(employerID uint, jobID uint) error {
 		return errors.New("unauthorized to delete this job")
 	}
 
-	return s.jobRepo.Delete(jobID)
+	// Jobs are soft-deleted so applications keep pointing at them
+	revision := newJobRevision(JobActionDeleted, job.Employer.UserID, jobSnapshot(job), nil)
+	if err := s.jobRepo.DeleteWithRevision(job, revision); err != nil {
+		return errors.New("failed to delete job")
+	}
+
+	return nil
+}
+
+func (s *jobService) GetDeletedJobs(employerID uint) ([]models.Job, error) {
+	return s.jobRepo.GetDeletedByEmployerID(employerID)
+}
+
+func (s *jobService) RestoreJob(employerID uint, jobID uint) (*models.Job, error) {
+	job, err := s.jobRepo.GetDeletedByID(jobID)
+	if err != nil {
+		return nil, errors.New("deleted job not found")
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to restore this job")
+	}
+
+	revision := newJobRevision(JobActionRestored, job.Employer.UserID, jobSnapshot(job), nil)
+	if err := s.jobRepo.RestoreWithRevision(job, revision); err != nil {
+		return nil, errors.New("failed to restore job")
+	}
+
+	return job, nil
 }
 
 func (s *jobService) GetEmployerJobs(employerID uint, filters JobFilters) ([]models.Job, error) {
@@ -271,6 +455,7 @@ func (s *jobService) GetEmployerJobs(employerID uint, filters JobFilters) ([]mod
 		EmploymentMode: filters.EmploymentMode,
 		IsPaid:         filters.IsPaid,
 		IsActive:       filters.IsActive,
+		IsDraft:        filters.IsDraft,
 		OrderBy:        filters.OrderBy,
 		OrderDirection: filters.OrderDirection,
 		Limit:          filters.Limit,
@@ -280,8 +465,9 @@ func (s *jobService) GetEmployerJobs(employerID uint, filters JobFilters) ([]mod
 }
 
 func (s *jobService) GetAllJobs(filters JobFilters) ([]models.Job, error) {
-	// For public job browsing, always filter to only active jobs
+	// For public job browsing, always filter to only active, published jobs
 	activeOnly := true
+	publishedOnly := false
 	
 	repoFilters := repositories.JobFilters{
 		Industry:       filters.Industry,
@@ -291,6 +477,7 @@ func (s *jobService) GetAllJobs(filters JobFilters) ([]models.Job, error) {
 		EmploymentMode: filters.EmploymentMode,
 		IsPaid:         filters.IsPaid,
 		IsActive:       &activeOnly,  // Force active jobs only for public browsing
+		IsDraft:        &publishedOnly,
 		OrderBy:        filters.OrderBy,
 		OrderDirection: filters.OrderDirection,
 		Limit:          filters.Limit,
@@ -309,15 +496,62 @@ func (s *jobService) ToggleJobStatus(employerID uint, jobID uint) (*models.Job,
 		return nil, errors.New("unauthorized to modify this job")
 	}
 
+	if job.IsDraft {
+		return nil, errors.New("draft jobs must be published before they can be activated")
+	}
+
+	if !job.IsActive && job.RemainingOpenings == 0 {
+		return nil, errors.New("every opening is filled; add openings before reactivating this job")
+	}
+
+	before := jobSnapshot(job)
 	job.IsActive = !job.IsActive
 
-	if err := s.jobRepo.Update(job); err != nil {
+	action := JobActionDeactivated
+	if job.IsActive {
+		action = JobActionActivated
+	}
+
+	after := jobSnapshot(job)
+	revision := newJobRevision(action, job.Employer.UserID, after, diffJobSnapshots(before, after))
+	if err := s.jobRepo.UpdateWithRevision(job, revision); err != nil {
 		return nil, errors.New("failed to update job status")
 	}
 
 	return job, nil
 }
 
+// closeFilledJob closes the job once its selected candidates fill every
+// opening. It runs after the change that filled the opening is saved, so
+// failures are only logged.
+func closeFilledJob(jobRepo repositories.JobRepository, jobID uint, actorUserID uint) {
+	job, err := jobRepo.GetByID(jobID)
+	if err == nil {
+		_, err = closeJobIfFilled(jobRepo, job, actorUserID)
+	}
+	if err != nil {
+		log.Printf("Failed to close filled job %d: %v", jobID, err)
+	}
+}
+
+// closeJobIfFilled deactivates an active job once every opening is filled and
+// records why in the job's history. It reports whether the job was closed.
+func closeJobIfFilled(jobRepo repositories.JobRepository, job *models.Job, actorUserID uint) (bool, error) {
+	if !job.IsActive || job.FilledOpenings < int64(job.Openings) {
+		return false, nil
+	}
+
+	before := jobSnapshot(job)
+	job.IsActive = false
+	after := jobSnapshot(job)
+
+	revision := newJobRevision(JobActionFilled, actorUserID, after, diffJobSnapshots(before, after))
+	if err := jobRepo.UpdateWithRevision(job, revision); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (s *jobService) GetEmployerDashboardStats(employerID uint) (map[string]interface{}, error) {
 	totalJobs, err := s.jobRepo.CountByEmployerID(employerID)
 	if err != nil {
@@ -330,15 +564,40 @@ func (s *jobService) GetEmployerDashboardStats(employerID uint) (map[string]inte
 	}
 
 	activeJobs := int64(0)
+	draftJobs := int64(0)
 	for _, job := range jobs {
 		if job.IsActive {
 			activeJobs++
 		}
+		if job.IsDraft {
+			draftJobs++
+		}
+	}
+
+	if err := s.offerRepo.ExpireOverdue(); err != nil {
+		return nil, err
 	}
+	offerCounts, err := s.offerRepo.CountByStatusForEmployer(employerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Acceptance rate only counts offers the candidate has decided on
+	offers := map[string]interface{}{}
+	for _, status := range []string{models.OfferStatusPending, models.OfferStatusAccepted, models.OfferStatusDeclined, models.OfferStatusWithdrawn, models.OfferStatusExpired} {
+		offers[status] = offerCounts[status]
+	}
+	acceptanceRate := 0.0
+	if decided := offerCounts[models.OfferStatusAccepted] + offerCounts[models.OfferStatusDeclined] + offerCounts[models.OfferStatusExpired]; decided > 0 {
+		acceptanceRate = float64(offerCounts[models.OfferStatusAccepted]) / float64(decided)
+	}
+	offers["acceptance_rate"] = acceptanceRate
 
 	stats := map[string]interface{}{
 		"total_jobs":  totalJobs,
 		"active_jobs": activeJobs,
+		"draft_jobs":  draftJobs,
+		"offers":      offers,
 		"recent_jobs": jobs[:min(5, len(jobs))], // Last 5 jobs
 	}
 
@@ -377,6 +636,322 @@ func (s *jobService) GetFilterOptions() (*FilterOptions, error) {
 	}, nil
 }
 
+func (s *jobService) GetPublishedJob(id uint) (*models.Job, error) {
+	job, err := s.getJob(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drafts are only visible to the employer that owns them
+	if job.IsDraft {
+		return nil, errors.New("job not found")
+	}
+
+	hideKnockoutRules(job.ScreeningQuestions)
+	return job, nil
+}
+
+func (s *jobService) SaveDraft(employerID uint, req SaveJobDraftRequest) (*models.Job, error) {
+	employer, err := s.employerRepo.GetByID(employerID)
+	if err != nil {
+		return nil, errors.New("employer not found")
+	}
+
+	questions, err := buildScreeningQuestions(req.ScreeningQuestions)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &models.Job{
+		EmployerID:          employerID,
+		Title:               req.Title,
+		JobType:             req.JobType,
+		Industry:            req.Industry,
+		TargetAudience:      req.TargetAudience,
+		EmploymentMode:      req.EmploymentMode,
+		StartMonth:          req.StartMonth,
+		Duration:            req.Duration,
+		CompensationRange:   req.CompensationRange,
+		IsPaid:              req.IsPaid,
+		City:                req.City,
+		State:               req.State,
+		RequiredSkills:      utils.ArrayToJSON(req.RequiredSkills),
+		MinExperience:       req.MinExperience,
+		PortfolioRequired:   req.PortfolioRequired,
+		ResumeRequired:      req.ResumeRequired,
+		Description:         req.Description,
+		AboutTeam:           req.AboutTeam,
+		ContactEmail:        req.ContactEmail,
+		IsActive:            false,
+		IsDraft:             true,
+		Openings:            max(req.Openings, 1),
+		ScreeningQuestions:  questions,
+	}
+	job.RemainingOpenings = int64(job.Openings)
+	if req.ApplicationDeadline != nil {
+		job.ApplicationDeadline = *req.ApplicationDeadline
+	}
+
+	revision := newJobRevision(JobActionDraftCreated, employer.UserID, jobSnapshot(job), nil)
+	if err := s.jobRepo.CreateWithRevision(job, revision); err != nil {
+		return nil, errors.New("failed to save job draft")
+	}
+
+	job.Employer = *employer
+	return job, nil
+}
+
+func (s *jobService) PreviewJob(employerID uint, jobID uint) (*JobPreview, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to preview this job")
+	}
+
+	issues := []string{}
+	if err := validateJobForPublish(createRequestFromJob(job)); err != nil {
+		issues = describeValidationError(err)
+	}
+
+	return &JobPreview{
+		Job:            job,
+		ReadyToPublish: len(issues) == 0,
+		Issues:         issues,
+	}, nil
+}
+
+func (s *jobService) PublishJob(employerID uint, jobID uint) (*models.Job, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to publish this job")
+	}
+
+	if !job.IsDraft {
+		return nil, errors.New("job is already published")
+	}
+
+	// Drafts go through the same checks as jobs created directly
+	if err := validateJobForPublish(createRequestFromJob(job)); err != nil {
+		return nil, err
+	}
+
+	before := jobSnapshot(job)
+	job.IsDraft = false
+	job.IsActive = true
+
+	after := jobSnapshot(job)
+	revision := newJobRevision(JobActionPublished, job.Employer.UserID, after, diffJobSnapshots(before, after))
+	if err := s.jobRepo.UpdateWithRevision(job, revision); err != nil {
+		return nil, errors.New("failed to publish job")
+	}
+
+	return job, nil
+}
+
+func (s *jobService) CloneJob(employerID uint, jobID uint, req CloneJobRequest) (*models.Job, error) {
+	source, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if source.EmployerID != employerID {
+		return nil, errors.New("unauthorized to clone this job")
+	}
+
+	clone := *source
+	clone.ID = 0
+	clone.CreatedAt = time.Time{}
+	clone.UpdatedAt = time.Time{}
+	clone.Applications = nil
+	clone.Employer = models.Employer{}
+	clone.ScreeningQuestions = make([]models.ScreeningQuestion, len(source.ScreeningQuestions))
+	for i, question := range source.ScreeningQuestions {
+		question.ID = 0
+		question.JobID = 0
+		question.CreatedAt = time.Time{}
+		clone.ScreeningQuestions[i] = question
+	}
+	clone.IsDraft = true
+	clone.IsActive = false
+	clone.ClosingNoticeAt = nil
+	clone.ExpiryNoticeAt = nil
+
+	if req.Title != "" {
+		clone.Title = req.Title
+	}
+	if req.StartMonth != "" {
+		clone.StartMonth = req.StartMonth
+	}
+	if req.Duration != "" {
+		clone.Duration = req.Duration
+	}
+	if req.ApplicationDeadline != nil {
+		clone.ApplicationDeadline = *req.ApplicationDeadline
+	}
+	if req.Openings > 0 {
+		clone.Openings = req.Openings
+	}
+	// Nobody has been selected for the new posting yet
+	clone.FilledOpenings = 0
+	clone.RemainingOpenings = int64(clone.Openings)
+
+	if req.Publish {
+		if err := validateJobForPublish(createRequestFromJob(&clone)); err != nil {
+			return nil, err
+		}
+		clone.IsDraft = false
+		clone.IsActive = true
+	}
+
+	revision := newJobRevision(JobActionCloned, source.Employer.UserID, jobSnapshot(&clone), nil)
+	if err := s.jobRepo.CreateWithRevision(&clone, revision); err != nil {
+		return nil, errors.New("failed to clone job")
+	}
+
+	// Carry over a custom pipeline, the default one needs no copying
+	stages, err := s.pipelineRepo.GetByJobID(source.ID)
+	if err != nil {
+		return nil, errors.New("failed to clone job pipeline")
+	}
+	if len(stages) > 0 {
+		for i := range stages {
+			stages[i].ID = 0
+			stages[i].CreatedAt = time.Time{}
+		}
+		if err := s.pipelineRepo.ReplaceForJob(clone.ID, stages); err != nil {
+			return nil, errors.New("failed to clone job pipeline")
+		}
+	}
+
+	clone.Employer = source.Employer
+	return &clone, nil
+}
+
+func (s *jobService) GetJobHistory(employerID uint, jobID uint) ([]models.JobRevision, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to view history for this job")
+	}
+
+	return s.jobRevisionRepo.GetByJobID(jobID)
+}
+
+func (s *jobService) GetPipeline(employerID uint, jobID uint) ([]models.PipelineStage, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to view the pipeline for this job")
+	}
+
+	return loadPipeline(s.pipelineRepo, jobID)
+}
+
+func (s *jobService) UpdatePipeline(employerID uint, jobID uint, req UpdatePipelineRequest) ([]models.PipelineStage, error) {
+	job, err := s.jobRepo.GetByID(jobID)
+	if err != nil {
+		return nil, errors.New("job not found")
+	}
+
+	if job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to change the pipeline for this job")
+	}
+
+	stages, err := buildPipelineStages(req.Stages)
+	if err != nil {
+		return nil, err
+	}
+
+	// Candidates can't be left in a stage that no longer exists
+	inUse, err := s.pipelineRepo.GetStagesInUse(jobID)
+	if err != nil {
+		return nil, errors.New("failed to update pipeline")
+	}
+	for _, key := range inUse {
+		if findStage(stages, key) == nil {
+			return nil, fmt.Errorf("stage %q still has applications and cannot be removed", key)
+		}
+	}
+
+	if err := s.pipelineRepo.ReplaceForJob(jobID, stages); err != nil {
+		return nil, errors.New("failed to update pipeline")
+	}
+
+	return stages, nil
+}
+
+// validateJobForPublish applies the CreateJobRequest binding rules plus the
+// business checks a job must pass before it becomes visible to job seekers.
+func validateJobForPublish(req CreateJobRequest) error {
+	if err := binding.Validator.ValidateStruct(req); err != nil {
+		return err
+	}
+
+	if req.ApplicationDeadline.Before(time.Now()) {
+		return errors.New("application deadline cannot be in the past")
+	}
+
+	return nil
+}
+
+func createRequestFromJob(job *models.Job) CreateJobRequest {
+	return CreateJobRequest{
+		Title:               job.Title,
+		JobType:             job.JobType,
+		Industry:            job.Industry,
+		TargetAudience:      job.TargetAudience,
+		EmploymentMode:      job.EmploymentMode,
+		StartMonth:          job.StartMonth,
+		Duration:            job.Duration,
+		ApplicationDeadline: job.ApplicationDeadline,
+		CompensationRange:   job.CompensationRange,
+		IsPaid:              job.IsPaid,
+		City:                job.City,
+		State:               job.State,
+		RequiredSkills:      utils.JSONToArray(job.RequiredSkills),
+		MinExperience:       job.MinExperience,
+		PortfolioRequired:   job.PortfolioRequired,
+		ResumeRequired:      job.ResumeRequired,
+		Description:         job.Description,
+		AboutTeam:           job.AboutTeam,
+		ContactEmail:        job.ContactEmail,
+		Openings:            job.Openings,
+	}
+}
+
+func describeValidationError(err error) []string {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []string{err.Error()}
+	}
+
+	issues := make([]string, 0, len(validationErrors))
+	for _, fieldErr := range validationErrors {
+		switch fieldErr.Tag() {
+		case "required":
+			issues = append(issues, fmt.Sprintf("%s is required", fieldErr.Field()))
+		case "min":
+			issues = append(issues, fmt.Sprintf("%s must have at least %s item(s)", fieldErr.Field(), fieldErr.Param()))
+		default:
+			issues = append(issues, fmt.Sprintf("%s is invalid (%s)", fieldErr.Field(), fieldErr.Tag()))
+		}
+	}
+	return issues
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+)
+
+// Mailer delivers a single rendered email
+type Mailer interface {
+	Send(email *models.OutboxEmail) error
+}
+
+type smtpMailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// NewSMTPMailer sends through an SMTP server. Authentication is only used
+// when a username is configured, which lets local capture servers such as
+// Mailpit work without credentials.
+func NewSMTPMailer(host string, port string, username string, password string, from string) Mailer {
+	return &smtpMailer{
+		addr:     net.JoinHostPort(host, port),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+func (m *smtpMailer) Send(email *models.OutboxEmail) error {
+	message, err := buildMIMEMessage(m.from, email)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	return smtp.SendMail(m.addr, auth, m.from, []string{email.ToEmail}, message)
+}
+
+type logMailer struct{}
+
+// NewLogMailer only logs emails; used when no SMTP server is configured
+func NewLogMailer() Mailer {
+	return &logMailer{}
+}
+
+func (m *logMailer) Send(email *models.OutboxEmail) error {
+	log.Printf("📧 Email to %s: %s\n%s", email.ToEmail, email.Subject, email.TextBody)
+	return nil
+}
+
+// buildMIMEMessage builds a multipart/alternative message so clients that
+// can't render HTML show the plain-text version.
+func buildMIMEMessage(from string, email *models.OutboxEmail) ([]byte, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", email.TextBody},
+		{"text/html; charset=utf-8", email.HTMLBody},
+	}
+	for _, part := range parts {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		encoder := quotedprintable.NewWriter(partWriter)
+		if _, err := encoder.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := encoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	// Strip line breaks so user-provided text can't inject headers
+	subject := strings.NewReplacer("\r", " ", "\n", " ").Replace(email.Subject)
+
+	var message bytes.Buffer
+	fmt.Fprintf(&message, "From: %s\r\n", from)
+	fmt.Fprintf(&message, "To: %s\r\n", email.ToEmail)
+	fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&message, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&message, "Content-Type: multipart/alternative; boundary=%s\r\n", writer.Boundary())
+	fmt.Fprintf(&message, "\r\n")
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+)
+
+const subscriberBufferSize = 16
+
+// NotificationHub fans notifications out to the live connections of a user.
+// It is in-process only, so each server instance serves its own clients.
+type NotificationHub struct {
+	mu          sync.RWMutex
+	subscribers map[uint]map[chan models.Notification]struct{}
+}
+
+func NewNotificationHub() *NotificationHub {
+	return &NotificationHub{
+		subscribers: map[uint]map[chan models.Notification]struct{}{},
+	}
+}
+
+// Subscribe registers a listener for the user. The returned function must be
+// called when the listener goes away.
+func (h *NotificationHub) Subscribe(userID uint) (<-chan models.Notification, func()) {
+	ch := make(chan models.Notification, subscriberBufferSize)
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = map[chan models.Notification]struct{}{}
+	}
+	h.subscribers[userID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subscribers[userID][ch]; !ok {
+			return
+		}
+		delete(h.subscribers[userID], ch)
+		if len(h.subscribers[userID]) == 0 {
+			delete(h.subscribers, userID)
+		}
+		close(ch)
+	}
+
+	return ch, unsubscribe
+}
+
+// Publish delivers the notification to every listener of the user. Slow
+// listeners are skipped rather than blocking the caller; they can catch up
+// through the REST endpoint.
+func (h *NotificationHub) Publish(userID uint, notification models.Notification) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for ch := range h.subscribers[userID] {
+		select {
+		case ch <- notification:
+		default:
+		}
+	}
+}
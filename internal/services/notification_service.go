@@ -0,0 +1,112 @@
+package services
+
+import (
+	"errors"
+	"log"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+)
+
+// Notification types
+const (
+	NotificationStatusChanged  = "application_status_changed"
+	NotificationNewMessage     = "new_message"
+	NotificationNewApplicant   = "new_applicant"
+	NotificationJobClosingSoon = "job_closing_soon"
+
+	NotificationInterviewProposed  = "interview_proposed"
+	NotificationInterviewScheduled = "interview_scheduled"
+	NotificationInterviewCancelled = "interview_cancelled"
+	NotificationInterviewReminder  = "interview_reminder"
+
+	NotificationOfferReceived  = "offer_received"
+	NotificationOfferResponded = "offer_responded"
+
+	NotificationInvitationReceived = "invitation_received"
+	NotificationInvitationDeclined = "invitation_declined"
+)
+
+type NotificationFilters struct {
+	UnreadOnly bool `form:"unread"`
+	Page       int  `form:"page" binding:"omitempty,min=1"`
+	PageSize   int  `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+type NotificationService interface {
+	Notify(notification *models.Notification)
+	GetNotifications(userID uint, filters NotificationFilters) ([]models.Notification, *Pagination, error)
+	MarkRead(userID uint, notificationID uint) error
+	MarkAllRead(userID uint) error
+	GetUnreadCount(userID uint) (int64, error)
+	Subscribe(userID uint) (<-chan models.Notification, func())
+}
+
+type notificationService struct {
+	notificationRepo repositories.NotificationRepository
+	hub              *NotificationHub
+}
+
+func NewNotificationService(notificationRepo repositories.NotificationRepository, hub *NotificationHub) NotificationService {
+	return &notificationService{
+		notificationRepo: notificationRepo,
+		hub:              hub,
+	}
+}
+
+// Notify stores the notification and pushes it to the user's open streams.
+// Notifications are a side effect of other actions, so failures are logged
+// instead of failing the action that triggered them.
+func (s *notificationService) Notify(notification *models.Notification) {
+	if notification.UserID == 0 {
+		return
+	}
+
+	if err := s.notificationRepo.Create(notification); err != nil {
+		log.Printf("Failed to store %s notification for user %d: %v", notification.Type, notification.UserID, err)
+		return
+	}
+
+	s.hub.Publish(notification.UserID, *notification)
+}
+
+func (s *notificationService) GetNotifications(userID uint, filters NotificationFilters) ([]models.Notification, *Pagination, error) {
+	page := filters.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := filters.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	notifications, total, err := s.notificationRepo.GetByUserID(userID, filters.UnreadOnly, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return notifications, newPagination(page, pageSize, total), nil
+}
+
+func (s *notificationService) MarkRead(userID uint, notificationID uint) error {
+	updated, err := s.notificationRepo.MarkRead(userID, notificationID)
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return errors.New("notification not found")
+	}
+	return nil
+}
+
+func (s *notificationService) MarkAllRead(userID uint) error {
+	return s.notificationRepo.MarkAllRead(userID)
+}
+
+func (s *notificationService) GetUnreadCount(userID uint) (int64, error) {
+	return s.notificationRepo.CountUnread(userID)
+}
+
+func (s *notificationService) Subscribe(userID uint) (<-chan models.Notification, func()) {
+	return s.hub.Subscribe(userID)
+}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+)
+
+type CreateOfferRequest struct {
+	Stipend   string    `json:"stipend" form:"stipend" binding:"required,max=100"`
+	StartDate string    `json:"start_date" form:"start_date" binding:"required,datetime=2006-01-02"`
+	Duration  string    `json:"duration" form:"duration" binding:"required,max=50"`
+	Message   string    `json:"message" form:"message" binding:"max=5000"`
+	ExpiresAt time.Time `json:"expires_at" form:"expires_at" binding:"required"`
+}
+
+type DeclineOfferRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+type OfferService interface {
+	CreateOffer(employerID uint, userID uint, applicationID uint, req CreateOfferRequest, document *multipart.FileHeader) (*models.Offer, error)
+	GetApplicationOffers(employerID uint, applicationID uint) ([]models.Offer, error)
+	WithdrawOffer(employerID uint, offerID uint) (*models.Offer, error)
+	GetMyOffers(jobSeekerID uint) ([]models.Offer, error)
+	GetMyOffer(jobSeekerID uint, offerID uint) (*models.Offer, error)
+	AcceptOffer(jobSeekerID uint, offerID uint) (*models.Offer, error)
+	DeclineOffer(jobSeekerID uint, offerID uint, req DeclineOfferRequest) (*models.Offer, error)
+}
+
+type offerService struct {
+	offerRepo       repositories.OfferRepository
+	applicationRepo repositories.ApplicationRepository
+	jobRepo         repositories.JobRepository
+	fileService     FileService
+	notifications   NotificationService
+}
+
+func NewOfferService(
+	offerRepo repositories.OfferRepository,
+	applicationRepo repositories.ApplicationRepository,
+	jobRepo repositories.JobRepository,
+	fileService FileService,
+	notifications NotificationService,
+) OfferService {
+	return &offerService{
+		offerRepo:       offerRepo,
+		applicationRepo: applicationRepo,
+		jobRepo:         jobRepo,
+		fileService:     fileService,
+		notifications:   notifications,
+	}
+}
+
+func (s *offerService) CreateOffer(employerID uint, userID uint, applicationID uint, req CreateOfferRequest, document *multipart.FileHeader) (*models.Offer, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if application.Job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to make an offer for this application")
+	}
+
+	// Offers follow selection; move the candidate to a selected stage first
+	if application.Status != "selected" {
+		return nil, errors.New("only selected candidates can receive an offer")
+	}
+
+	if !req.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("offer expiry must be in the future")
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, errors.New("start date must be in YYYY-MM-DD format")
+	}
+
+	if err := s.offerRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+	active, err := s.offerRepo.HasActiveOffer(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, errors.New("this application already has an open or accepted offer")
+	}
+
+	offer := &models.Offer{
+		ApplicationID:   applicationID,
+		Status:          models.OfferStatusPending,
+		Stipend:         strings.TrimSpace(req.Stipend),
+		StartDate:       startDate,
+		Duration:        strings.TrimSpace(req.Duration),
+		Message:         strings.TrimSpace(req.Message),
+		ExpiresAt:       req.ExpiresAt,
+		CreatedByUserID: userID,
+	}
+
+	if document != nil {
+		url, err := s.fileService.UploadOfferDocument(userID, document)
+		if err != nil {
+			return nil, err
+		}
+		offer.DocumentURL = url
+		offer.DocumentName = filepath.Base(document.Filename)
+	}
+
+	if err := s.offerRepo.Create(offer); err != nil {
+		return nil, errors.New("failed to create offer")
+	}
+
+	// A new offer takes back an opening an earlier declined one freed up
+	closeFilledJob(s.jobRepo, application.JobID, userID)
+
+	s.notifications.Notify(&models.Notification{
+		UserID:        application.JobSeeker.UserID,
+		Type:          NotificationOfferReceived,
+		Title:         "You have an offer",
+		Body:          fmt.Sprintf("%s sent you an offer for %s. Respond by %s.", application.Job.Employer.CompanyName, application.Job.Title, offer.ExpiresAt.Format("Jan 2, 2006")),
+		JobID:         &application.JobID,
+		ApplicationID: &application.ID,
+	})
+
+	return offer, nil
+}
+
+func (s *offerService) GetApplicationOffers(employerID uint, applicationID uint) ([]models.Offer, error) {
+	application, err := s.applicationRepo.GetByID(applicationID)
+	if err != nil {
+		return nil, errors.New("application not found")
+	}
+
+	if application.Job.EmployerID != employerID {
+		return nil, errors.New("unauthorized to view offers for this application")
+	}
+
+	if err := s.offerRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+
+	return s.offerRepo.GetByApplicationID(applicationID)
+}
+
+func (s *offerService) WithdrawOffer(employerID uint, offerID uint) (*models.Offer, error) {
+	offer, err := s.getPending(offerID, func(offer *models.Offer) bool {
+		return offer.Application.Job.EmployerID == employerID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	offer.Status = models.OfferStatusWithdrawn
+	if err := s.offerRepo.Update(offer); err != nil {
+		return nil, errors.New("failed to withdraw offer")
+	}
+
+	return offer, nil
+}
+
+func (s *offerService) GetMyOffers(jobSeekerID uint) ([]models.Offer, error) {
+	if err := s.offerRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+
+	return s.offerRepo.GetByJobSeekerID(jobSeekerID)
+}
+
+func (s *offerService) GetMyOffer(jobSeekerID uint, offerID uint) (*models.Offer, error) {
+	if err := s.offerRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+
+	offer, err := s.offerRepo.GetByID(offerID)
+	if err != nil || offer.Application.JobSeekerID != jobSeekerID {
+		return nil, errors.New("offer not found")
+	}
+
+	return offer, nil
+}
+
+// getPending loads an offer that can still be responded to. Offers that
+// owns rejects look the same as ones that don't exist, so their status isn't
+// revealed either.
+func (s *offerService) getPending(offerID uint, owns func(offer *models.Offer) bool) (*models.Offer, error) {
+	if err := s.offerRepo.ExpireOverdue(); err != nil {
+		return nil, err
+	}
+
+	offer, err := s.offerRepo.GetByID(offerID)
+	if err != nil || !owns(offer) {
+		return nil, errors.New("offer not found")
+	}
+
+	if offer.Status != models.OfferStatusPending {
+		return nil, fmt.Errorf("offer is already %s", offer.Status)
+	}
+
+	return offer, nil
+}
+
+func (s *offerService) AcceptOffer(jobSeekerID uint, offerID uint) (*models.Offer, error) {
+	return s.respond(jobSeekerID, offerID, models.OfferStatusAccepted, "")
+}
+
+func (s *offerService) DeclineOffer(jobSeekerID uint, offerID uint, req DeclineOfferRequest) (*models.Offer, error) {
+	return s.respond(jobSeekerID, offerID, models.OfferStatusDeclined, strings.TrimSpace(req.Reason))
+}
+
+func (s *offerService) respond(jobSeekerID uint, offerID uint, status string, reason string) (*models.Offer, error) {
+	offer, err := s.getPending(offerID, func(offer *models.Offer) bool {
+		return offer.Application.JobSeekerID == jobSeekerID
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	offer.Status = status
+	offer.RespondedAt = &now
+	offer.DeclineReason = reason
+	if err := s.offerRepo.Update(offer); err != nil {
+		return nil, errors.New("failed to respond to offer")
+	}
+
+	application := offer.Application
+	if status == models.OfferStatusAccepted {
+		closeFilledJob(s.jobRepo, application.JobID, application.JobSeeker.UserID)
+	}
+	s.notifications.Notify(&models.Notification{
+		UserID:        application.Job.Employer.UserID,
+		Type:          NotificationOfferResponded,
+		Title:         "Offer " + status,
+		Body:          fmt.Sprintf("%s %s your offer for %s", application.JobSeeker.FullName, status, application.Job.Title),
+		JobID:         &application.JobID,
+		ApplicationID: &application.ID,
+	})
+
+	return offer, nil
+}
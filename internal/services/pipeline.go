@@ -0,0 +1,161 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+var stageKeyPattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type PipelineStageInput struct {
+	Key         string   `json:"key" binding:"required,max=50"`
+	Name        string   `json:"name" binding:"required,max=100"`
+	Status      string   `json:"status" binding:"required,oneof=applied shortlisted rejected selected"`
+	AllowedNext []string `json:"allowed_next"`
+}
+
+type UpdatePipelineRequest struct {
+	Stages []PipelineStageInput `json:"stages" binding:"required,min=2,max=20,dive"`
+}
+
+// defaultPipeline is used for jobs that haven't configured their own stages.
+// It mirrors the original four statuses.
+func defaultPipeline() []models.PipelineStage {
+	return []models.PipelineStage{
+		{Key: "applied", Name: "Applied", Position: 0, Status: "applied", AllowedNext: `["shortlisted","rejected","selected"]`},
+		{Key: "shortlisted", Name: "Shortlisted", Position: 1, Status: "shortlisted", AllowedNext: `["selected","rejected"]`},
+		{Key: "rejected", Name: "Rejected", Position: 2, Status: "rejected", AllowedNext: `["shortlisted"]`},
+		{Key: "selected", Name: "Selected", Position: 3, Status: "selected", AllowedNext: `[]`},
+	}
+}
+
+// loadPipeline returns the job's configured stages, falling back to the
+// default pipeline.
+func loadPipeline(repo repositories.PipelineStageRepository, jobID uint) ([]models.PipelineStage, error) {
+	stages, err := repo.GetByJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return defaultPipeline(), nil
+	}
+	return stages, nil
+}
+
+// buildPipelineStages validates an employer-defined pipeline. The first stage
+// is where new applications land and there must be somewhere to reject to.
+func buildPipelineStages(inputs []PipelineStageInput) ([]models.PipelineStage, error) {
+	if len(inputs) == 0 {
+		return nil, errors.New("pipeline must have at least one stage")
+	}
+	if inputs[0].Status != "applied" {
+		return nil, errors.New("the first pipeline stage must have the applied status")
+	}
+
+	keys := map[string]bool{}
+	hasRejection := false
+	for _, input := range inputs {
+		if !stageKeyPattern.MatchString(input.Key) {
+			return nil, fmt.Errorf("stage key %q may only contain lowercase letters, numbers and underscores", input.Key)
+		}
+		if keys[input.Key] {
+			return nil, fmt.Errorf("stage key %q is used more than once", input.Key)
+		}
+		keys[input.Key] = true
+		if input.Status == "rejected" {
+			hasRejection = true
+		}
+	}
+	if !hasRejection {
+		return nil, errors.New("pipeline must have at least one stage with the rejected status")
+	}
+
+	stages := make([]models.PipelineStage, 0, len(inputs))
+	for i, input := range inputs {
+		seen := map[string]bool{}
+		for _, next := range input.AllowedNext {
+			if !keys[next] {
+				return nil, fmt.Errorf("stage %q allows moving to unknown stage %q", input.Key, next)
+			}
+			if next == input.Key {
+				return nil, fmt.Errorf("stage %q cannot transition to itself", input.Key)
+			}
+			if seen[next] {
+				return nil, fmt.Errorf("stage %q lists %q more than once", input.Key, next)
+			}
+			seen[next] = true
+		}
+
+		stages = append(stages, models.PipelineStage{
+			Key:         input.Key,
+			Name:        input.Name,
+			Position:    i,
+			Status:      input.Status,
+			AllowedNext: utils.ArrayToJSON(input.AllowedNext),
+		})
+	}
+
+	return stages, nil
+}
+
+// ResolveStageTransition works out which stage an application moves to and
+// checks that the pipeline allows it. When only a status is given, the first
+// allowed next stage with that status is picked.
+func ResolveStageTransition(stages []models.PipelineStage, currentStage string, targetStage string, targetStatus string) (*models.PipelineStage, error) {
+	current := findStage(stages, currentStage)
+	if current == nil {
+		return nil, fmt.Errorf("current stage %q is not part of this job's pipeline", currentStage)
+	}
+
+	allowed := utils.JSONToArray(current.AllowedNext)
+
+	if targetStage == "" {
+		if targetStatus == "" {
+			return nil, errors.New("stage or status is required")
+		}
+		for _, key := range allowed {
+			if next := findStage(stages, key); next != nil && next.Status == targetStatus {
+				return next, nil
+			}
+		}
+		return nil, fmt.Errorf("cannot move application from %s to %s", current.Name, targetStatus)
+	}
+
+	target := findStage(stages, targetStage)
+	if target == nil {
+		return nil, fmt.Errorf("stage %q is not part of this job's pipeline", targetStage)
+	}
+	if targetStatus != "" && target.Status != targetStatus {
+		return nil, fmt.Errorf("stage %s does not have the %s status", target.Name, targetStatus)
+	}
+	for _, key := range allowed {
+		if key == target.Key {
+			return target, nil
+		}
+	}
+	return nil, fmt.Errorf("cannot move application from %s to %s", current.Name, target.Name)
+}
+
+func findStage(stages []models.PipelineStage, key string) *models.PipelineStage {
+	for i := range stages {
+		if stages[i].Key == key {
+			return &stages[i]
+		}
+	}
+	return nil
+}
+
+// rejectionStage is where knocked-out candidates are placed
+func rejectionStage(stages []models.PipelineStage) *models.PipelineStage {
+	for i := range stages {
+		if stages[i].Status == "rejected" {
+			return &stages[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+const (
+	MaxPortfolioProjects = 30
+	MaxProjectMedia      = 40
+	// MaxApplicationProjects is how many projects can be attached to one application
+	MaxApplicationProjects = 10
+)
+
+type PortfolioProjectRequest struct {
+	Title        string   `json:"title" binding:"required,max=255"`
+	Year         int      `json:"year" binding:"omitempty,min=1950,max=2100"`
+	Typology     string   `json:"typology" binding:"max=100"`
+	Role         string   `json:"role" binding:"max=255"`
+	Description  string   `json:"description" binding:"max=5000"`
+	SoftwareUsed []string `json:"software_used" binding:"omitempty,max=20"`
+}
+
+// ReorderRequest lists every project (or every media item of a project) in
+// the order it should be shown
+type ReorderRequest struct {
+	IDs []uint `json:"ids" binding:"required,min=1"`
+}
+
+// SharedPortfolio is what the public portfolio link shows. Contact details
+// stay private.
+type SharedPortfolio struct {
+	FullName     string                    `json:"full_name"`
+	DesiredField string                    `json:"desired_field"`
+	CurrentCity  string                    `json:"current_city"`
+	Projects     []models.PortfolioProject `json:"projects"`
+}
+
+type PortfolioService interface {
+	GetProjects(jobSeekerID uint) ([]models.PortfolioProject, error)
+	CreateProject(jobSeekerID uint, req PortfolioProjectRequest) (*models.PortfolioProject, error)
+	UpdateProject(jobSeekerID uint, projectID uint, req PortfolioProjectRequest) (*models.PortfolioProject, error)
+	DeleteProject(jobSeekerID uint, projectID uint) error
+	ReorderProjects(jobSeekerID uint, req ReorderRequest) ([]models.PortfolioProject, error)
+	UploadMedia(jobSeekerID uint, userID uint, projectID uint, files []*multipart.FileHeader, captions []string) (*models.PortfolioProject, error)
+	DeleteMedia(jobSeekerID uint, projectID uint, mediaID uint) error
+	ReorderMedia(jobSeekerID uint, projectID uint, req ReorderRequest) (*models.PortfolioProject, error)
+	CreateShareToken(jobSeekerID uint) (string, error)
+	DisableSharing(jobSeekerID uint) error
+	GetSharedPortfolio(token string) (*SharedPortfolio, error)
+}
+
+type portfolioService struct {
+	portfolioRepo repositories.PortfolioRepository
+	jobSeekerRepo repositories.JobSeekerRepository
+	fileService   FileService
+}
+
+func NewPortfolioService(
+	portfolioRepo repositories.PortfolioRepository,
+	jobSeekerRepo repositories.JobSeekerRepository,
+	fileService FileService,
+) PortfolioService {
+	return &portfolioService{
+		portfolioRepo: portfolioRepo,
+		jobSeekerRepo: jobSeekerRepo,
+		fileService:   fileService,
+	}
+}
+
+func (s *portfolioService) GetProjects(jobSeekerID uint) ([]models.PortfolioProject, error) {
+	return s.portfolioRepo.GetProjects(jobSeekerID)
+}
+
+func (s *portfolioService) CreateProject(jobSeekerID uint, req PortfolioProjectRequest) (*models.PortfolioProject, error) {
+	count, err := s.portfolioRepo.CountProjects(jobSeekerID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= MaxPortfolioProjects {
+		return nil, fmt.Errorf("a portfolio can have up to %d projects", MaxPortfolioProjects)
+	}
+
+	project := &models.PortfolioProject{JobSeekerID: jobSeekerID}
+	applyProjectRequest(project, req)
+
+	if err := s.portfolioRepo.CreateProject(project); err != nil {
+		return nil, errors.New("failed to create project")
+	}
+
+	project.Media = []models.PortfolioMedia{}
+	return project, nil
+}
+
+func (s *portfolioService) UpdateProject(jobSeekerID uint, projectID uint, req PortfolioProjectRequest) (*models.PortfolioProject, error) {
+	project, err := s.getProject(jobSeekerID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	applyProjectRequest(project, req)
+	if err := s.portfolioRepo.UpdateProject(project); err != nil {
+		return nil, errors.New("failed to update project")
+	}
+
+	return project, nil
+}
+
+func (s *portfolioService) DeleteProject(jobSeekerID uint, projectID uint) error {
+	project, err := s.getProject(jobSeekerID, projectID)
+	if err != nil {
+		return err
+	}
+
+	return s.portfolioRepo.DeleteProject(project.ID)
+}
+
+func (s *portfolioService) ReorderProjects(jobSeekerID uint, req ReorderRequest) ([]models.PortfolioProject, error) {
+	projects, err := s.portfolioRepo.GetProjects(jobSeekerID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make([]uint, 0, len(projects))
+	for _, project := range projects {
+		existing = append(existing, project.ID)
+	}
+	if err := validateOrder(existing, req.IDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.portfolioRepo.ReorderProjects(jobSeekerID, req.IDs); err != nil {
+		return nil, errors.New("failed to reorder projects")
+	}
+
+	return s.portfolioRepo.GetProjects(jobSeekerID)
+}
+
+// UploadMedia stores the files and appends them to the project in the order
+// given; captions[i], if present, describes files[i]
+func (s *portfolioService) UploadMedia(jobSeekerID uint, userID uint, projectID uint, files []*multipart.FileHeader, captions []string) (*models.PortfolioProject, error) {
+	project, err := s.getProject(jobSeekerID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("no files uploaded")
+	}
+	if len(project.Media)+len(files) > MaxProjectMedia {
+		return nil, fmt.Errorf("a project can have up to %d images and PDF pages", MaxProjectMedia)
+	}
+
+	media := make([]models.PortfolioMedia, 0, len(files))
+	for i, file := range files {
+		url, err := s.fileService.UploadPortfolioMedia(userID, file)
+		if err != nil {
+			return nil, err
+		}
+
+		item := models.PortfolioMedia{
+			ProjectID: project.ID,
+			URL:       url,
+			MediaType: models.PortfolioMediaImage,
+		}
+		if strings.EqualFold(filepath.Ext(file.Filename), ".pdf") {
+			item.MediaType = models.PortfolioMediaPDF
+		}
+		if i < len(captions) {
+			item.Caption = strings.TrimSpace(captions[i])
+		}
+		media = append(media, item)
+	}
+
+	if err := s.portfolioRepo.AddMedia(media); err != nil {
+		return nil, errors.New("failed to save media")
+	}
+
+	return s.portfolioRepo.GetProject(jobSeekerID, project.ID)
+}
+
+func (s *portfolioService) DeleteMedia(jobSeekerID uint, projectID uint, mediaID uint) error {
+	project, err := s.getProject(jobSeekerID, projectID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.portfolioRepo.DeleteMedia(project.ID, mediaID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.New("media not found")
+	}
+
+	return nil
+}
+
+func (s *portfolioService) ReorderMedia(jobSeekerID uint, projectID uint, req ReorderRequest) (*models.PortfolioProject, error) {
+	project, err := s.getProject(jobSeekerID, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make([]uint, 0, len(project.Media))
+	for _, item := range project.Media {
+		existing = append(existing, item.ID)
+	}
+	if err := validateOrder(existing, req.IDs); err != nil {
+		return nil, err
+	}
+
+	if err := s.portfolioRepo.ReorderMedia(project.ID, req.IDs); err != nil {
+		return nil, errors.New("failed to reorder media")
+	}
+
+	return s.portfolioRepo.GetProject(jobSeekerID, project.ID)
+}
+
+// CreateShareToken issues a new secret for the public portfolio link,
+// replacing any earlier one so old links stop working
+func (s *portfolioService) CreateShareToken(jobSeekerID uint) (string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.New("failed to create portfolio link")
+	}
+	token := hex.EncodeToString(raw)
+
+	if err := s.jobSeekerRepo.SetPortfolioShareToken(jobSeekerID, &token); err != nil {
+		return "", errors.New("failed to create portfolio link")
+	}
+
+	return token, nil
+}
+
+func (s *portfolioService) DisableSharing(jobSeekerID uint) error {
+	return s.jobSeekerRepo.SetPortfolioShareToken(jobSeekerID, nil)
+}
+
+func (s *portfolioService) GetSharedPortfolio(token string) (*SharedPortfolio, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetByPortfolioShareToken(token)
+	if err != nil {
+		return nil, errors.New("portfolio not found")
+	}
+
+	projects, err := s.portfolioRepo.GetProjects(jobSeeker.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SharedPortfolio{
+		FullName:     jobSeeker.FullName,
+		DesiredField: jobSeeker.DesiredField,
+		CurrentCity:  jobSeeker.CurrentCity,
+		Projects:     projects,
+	}, nil
+}
+
+func (s *portfolioService) getProject(jobSeekerID uint, projectID uint) (*models.PortfolioProject, error) {
+	project, err := s.portfolioRepo.GetProject(jobSeekerID, projectID)
+	if err != nil {
+		return nil, errors.New("project not found")
+	}
+	return project, nil
+}
+
+func applyProjectRequest(project *models.PortfolioProject, req PortfolioProjectRequest) {
+	project.Title = strings.TrimSpace(req.Title)
+	project.Year = req.Year
+	project.Typology = strings.TrimSpace(req.Typology)
+	project.Role = strings.TrimSpace(req.Role)
+	project.Description = strings.TrimSpace(req.Description)
+	project.SoftwareUsed = utils.ArrayToJSON(req.SoftwareUsed)
+}
+
+// validateOrder checks that ordered lists each existing ID exactly once
+func validateOrder(existing []uint, ordered []uint) error {
+	if len(ordered) != len(existing) {
+		return errors.New("order must list every item exactly once")
+	}
+
+	remaining := make(map[uint]bool, len(existing))
+	for _, id := range existing {
+		remaining[id] = true
+	}
+	for _, id := range ordered {
+		if !remaining[id] {
+			return errors.New("order must list every item exactly once")
+		}
+		delete(remaining, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package services
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+
+	"gorm.io/gorm"
+)
+
+// WorkHistoryRequest is one position in a professional's work history.
+// Leave end_date empty for the current position.
+type WorkHistoryRequest struct {
+	Organization string `json:"organization" binding:"required,max=255"`
+	Title        string `json:"title" binding:"required,max=255"`
+	StartDate    string `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate      string `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	Description  string `json:"description" binding:"max=5000"`
+}
+
+type CreateProfessionalProfileRequest struct {
+	CurrentTitle          string               `json:"current_title" binding:"max=255"`
+	YearsOfExperience     int                  `json:"years_of_experience" binding:"min=0,max=60"`
+	CoARegistrationNumber string               `json:"coa_registration_number" binding:"max=50"`
+	OtherLicenses         []string             `json:"other_licenses" binding:"omitempty,max=20"`
+	WorkHistory           []WorkHistoryRequest `json:"work_history" binding:"omitempty,max=30,dive"`
+}
+
+// UpdateProfessionalProfileRequest leaves out fields that aren't sent.
+// Sending work_history replaces the whole list.
+type UpdateProfessionalProfileRequest struct {
+	CurrentTitle          string               `json:"current_title" binding:"max=255"`
+	YearsOfExperience     *int                 `json:"years_of_experience" binding:"omitempty,min=0,max=60"`
+	CoARegistrationNumber string               `json:"coa_registration_number" binding:"max=50"`
+	OtherLicenses         []string             `json:"other_licenses" binding:"omitempty,max=20"`
+	WorkHistory           []WorkHistoryRequest `json:"work_history" binding:"omitempty,max=30,dive"`
+}
+
+type ProfessionalProfileService interface {
+	CreateProfile(jobSeekerID uint, req CreateProfessionalProfileRequest) (*models.ProfessionalProfile, error)
+	GetProfile(jobSeekerID uint) (*models.ProfessionalProfile, error)
+	UpdateProfile(jobSeekerID uint, req UpdateProfessionalProfileRequest) (*models.ProfessionalProfile, error)
+	DeleteProfile(jobSeekerID uint) error
+	RestoreProfile(jobSeekerID uint) (*models.ProfessionalProfile, error)
+}
+
+type professionalProfileService struct {
+	professionalProfileRepo repositories.ProfessionalProfileRepository
+	jobSeekerRepo           repositories.JobSeekerRepository
+}
+
+func NewProfessionalProfileService(
+	professionalProfileRepo repositories.ProfessionalProfileRepository,
+	jobSeekerRepo repositories.JobSeekerRepository,
+) ProfessionalProfileService {
+	return &professionalProfileService{
+		professionalProfileRepo: professionalProfileRepo,
+		jobSeekerRepo:           jobSeekerRepo,
+	}
+}
+
+func (s *professionalProfileService) CreateProfile(jobSeekerID uint, req CreateProfessionalProfileRequest) (*models.ProfessionalProfile, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	if jobSeeker.JobSeekerType != "professional" {
+		return nil, errors.New("professional profile can only be created for professional job seekers")
+	}
+
+	existingProfile, err := s.professionalProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err == nil && existingProfile != nil {
+		return nil, errors.New("professional profile already exists")
+	}
+
+	history, err := buildWorkHistory(req.WorkHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &models.ProfessionalProfile{
+		JobSeekerID:           jobSeekerID,
+		CurrentTitle:          strings.TrimSpace(req.CurrentTitle),
+		YearsOfExperience:     req.YearsOfExperience,
+		CoARegistrationNumber: normalizeRegistrationNumber(req.CoARegistrationNumber),
+		OtherLicenses:         utils.ArrayToJSON(req.OtherLicenses),
+		WorkHistory:           history,
+	}
+
+	if err := s.professionalProfileRepo.Create(profile); err != nil {
+		return nil, errors.New("failed to create professional profile")
+	}
+
+	profile.JobSeeker = *jobSeeker
+	return profile, nil
+}
+
+func (s *professionalProfileService) GetProfile(jobSeekerID uint) (*models.ProfessionalProfile, error) {
+	_, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	profile, err := s.professionalProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("professional profile not found")
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (s *professionalProfileService) UpdateProfile(jobSeekerID uint, req UpdateProfessionalProfileRequest) (*models.ProfessionalProfile, error) {
+	profile, err := s.professionalProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("professional profile not found")
+	}
+
+	if req.CurrentTitle != "" {
+		profile.CurrentTitle = strings.TrimSpace(req.CurrentTitle)
+	}
+	if req.YearsOfExperience != nil {
+		profile.YearsOfExperience = *req.YearsOfExperience
+	}
+	if req.CoARegistrationNumber != "" {
+		profile.CoARegistrationNumber = normalizeRegistrationNumber(req.CoARegistrationNumber)
+	}
+	if len(req.OtherLicenses) > 0 {
+		profile.OtherLicenses = utils.ArrayToJSON(req.OtherLicenses)
+	}
+
+	if req.WorkHistory == nil {
+		if err := s.professionalProfileRepo.Update(profile); err != nil {
+			return nil, errors.New("failed to update professional profile")
+		}
+		return profile, nil
+	}
+
+	history, err := buildWorkHistory(req.WorkHistory)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.professionalProfileRepo.ReplaceWorkHistory(profile, history); err != nil {
+		return nil, errors.New("failed to update professional profile")
+	}
+
+	return profile, nil
+}
+
+func (s *professionalProfileService) DeleteProfile(jobSeekerID uint) error {
+	_, err := s.professionalProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return errors.New("professional profile not found")
+	}
+
+	return s.professionalProfileRepo.Delete(jobSeekerID)
+}
+
+func (s *professionalProfileService) RestoreProfile(jobSeekerID uint) (*models.ProfessionalProfile, error) {
+	existingProfile, err := s.professionalProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err == nil && existingProfile != nil {
+		return nil, errors.New("professional profile already exists")
+	}
+
+	deletedProfile, err := s.professionalProfileRepo.GetDeletedByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("deleted professional profile not found")
+	}
+
+	if err := s.professionalProfileRepo.Restore(deletedProfile.ID); err != nil {
+		return nil, errors.New("failed to restore professional profile")
+	}
+
+	return s.professionalProfileRepo.GetByJobSeekerID(jobSeekerID)
+}
+
+func buildWorkHistory(reqs []WorkHistoryRequest) ([]models.WorkHistoryEntry, error) {
+	history := make([]models.WorkHistoryEntry, 0, len(reqs))
+	current := 0
+	for _, req := range reqs {
+		startDate, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return nil, errors.New("start date must be in YYYY-MM-DD format")
+		}
+
+		entry := models.WorkHistoryEntry{
+			Organization: strings.TrimSpace(req.Organization),
+			Title:        strings.TrimSpace(req.Title),
+			StartDate:    startDate,
+			Description:  strings.TrimSpace(req.Description),
+		}
+
+		if req.EndDate == "" {
+			current++
+		} else {
+			endDate, err := time.Parse("2006-01-02", req.EndDate)
+			if err != nil {
+				return nil, errors.New("end date must be in YYYY-MM-DD format")
+			}
+			if endDate.Before(startDate) {
+				return nil, errors.New("end date cannot be before start date")
+			}
+			entry.EndDate = &endDate
+		}
+
+		history = append(history, entry)
+	}
+
+	if current > 1 {
+		return nil, errors.New("only one position can be current; add an end date to the others")
+	}
+
+	return history, nil
+}
+
+// normalizeRegistrationNumber stores registration numbers such as
+// "ca/2015/12345" in the council's upper-case form
+func normalizeRegistrationNumber(number string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(number), ""))
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"sort"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+// minProfileSkills is how many skills count as a complete skills section
+const minProfileSkills = 3
+
+// ProfileCompleteness scores a job seeker's profile out of 100 and lists
+// what's missing, most valuable first
+type ProfileCompleteness struct {
+	Score   int                  `json:"score"`
+	Missing []MissingProfileItem `json:"missing"`
+}
+
+type MissingProfileItem struct {
+	Field  string `json:"field"`
+	Hint   string `json:"hint"`
+	Weight int    `json:"weight"` // points the item adds to the score
+}
+
+type completenessCheck struct {
+	field    string
+	hint     string
+	weight   int
+	complete bool
+}
+
+// ComputeProfileCompleteness scores the job seeker's profile. The type
+// specific extension (student, professional or freelancer profile) should be
+// loaded; a missing extension counts against the score.
+func ComputeProfileCompleteness(jobSeeker *models.JobSeeker) ProfileCompleteness {
+	checks := []completenessCheck{
+		{
+			field:  "basic_details",
+			hint:   "Fill in your name, city, phone and desired field",
+			weight: 10,
+			complete: jobSeeker.FullName != "" && jobSeeker.CurrentCity != "" &&
+				jobSeeker.Phone != "" && jobSeeker.DesiredField != "",
+		},
+		{
+			field:    "resume",
+			hint:     "Upload your resume; many jobs require one to apply",
+			weight:   20,
+			complete: jobSeeker.ResumeURL != "",
+		},
+		{
+			field:    "portfolio",
+			hint:     "Add a portfolio PDF or portfolio projects; many firms require one to apply",
+			weight:   15,
+			complete: jobSeeker.PortfolioURL != "" || len(jobSeeker.PortfolioProjects) > 0,
+		},
+		{
+			field:    "skills",
+			hint:     "List at least 3 skills",
+			weight:   15,
+			complete: len(utils.JSONToArray(jobSeeker.Skills)) >= minProfileSkills,
+		},
+	}
+	checks = append(checks, extensionChecks(jobSeeker)...)
+
+	completeness := ProfileCompleteness{Missing: []MissingProfileItem{}}
+	for _, check := range checks {
+		if check.complete {
+			completeness.Score += check.weight
+			continue
+		}
+		completeness.Missing = append(completeness.Missing, MissingProfileItem{
+			Field:  check.field,
+			Hint:   check.hint,
+			Weight: check.weight,
+		})
+	}
+
+	sort.SliceStable(completeness.Missing, func(i, j int) bool {
+		return completeness.Missing[i].Weight > completeness.Missing[j].Weight
+	})
+
+	return completeness
+}
+
+// extensionChecks covers the profile extension for the job seeker's type,
+// worth 40 points in total
+func extensionChecks(jobSeeker *models.JobSeeker) []completenessCheck {
+	switch jobSeeker.JobSeekerType {
+	case "student":
+		student := jobSeeker.StudentProfile
+		if student == nil {
+			return []completenessCheck{{field: "student_profile", hint: "Add your college, degree and year", weight: 40}}
+		}
+		return []completenessCheck{
+			{field: "student_profile", weight: 20, complete: true},
+			{
+				field:    "software_proficiency",
+				hint:     "List the software you're proficient in",
+				weight:   10,
+				complete: len(utils.JSONToArray(student.SoftwareProficiency)) > 0,
+			},
+			{
+				field:    "experience",
+				hint:     "Add an internship or freelance project",
+				weight:   10,
+				complete: len(student.Internships) > 0 || len(student.FreelanceProjects) > 0,
+			},
+		}
+	case "professional":
+		professional := jobSeeker.ProfessionalProfile
+		if professional == nil {
+			return []completenessCheck{{field: "professional_profile", hint: "Add your experience and registration details", weight: 40}}
+		}
+		return []completenessCheck{
+			{field: "professional_profile", weight: 20, complete: true},
+			{
+				field:    "work_history",
+				hint:     "Add your work history",
+				weight:   20,
+				complete: len(professional.WorkHistory) > 0,
+			},
+		}
+	case "freelancer":
+		freelancer := jobSeeker.FreelancerProfile
+		if freelancer == nil {
+			return []completenessCheck{{field: "freelancer_profile", hint: "Add your rates, availability and services", weight: 40}}
+		}
+		return []completenessCheck{
+			{field: "freelancer_profile", weight: 20, complete: true},
+			{
+				field:    "services",
+				hint:     "List the services you offer",
+				weight:   10,
+				complete: len(utils.JSONToArray(freelancer.Services)) > 0,
+			},
+			{
+				field:    "rates",
+				hint:     "Add an hourly or project rate",
+				weight:   10,
+				complete: freelancer.HourlyRate > 0 || freelancer.ProjectRate != "",
+			},
+		}
+	}
+	return nil
+}
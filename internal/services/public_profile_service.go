@@ -0,0 +1,277 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+const (
+	// ProfileVisibilityPrivate profiles can't be viewed by anyone else
+	ProfileVisibilityPrivate = "private"
+	// ProfileVisibilityUnlisted profiles can be viewed by anyone with the
+	// link but aren't indexed by search engines
+	ProfileVisibilityUnlisted = "unlisted"
+	ProfileVisibilityPublic   = "public"
+)
+
+// PublicProfileSettingsRequest leaves out settings that aren't sent
+type PublicProfileSettingsRequest struct {
+	Slug       string `json:"slug" binding:"omitempty,min=3,max=60"`
+	Visibility string `json:"visibility" binding:"omitempty,oneof=private unlisted public"`
+	HidePhone  *bool  `json:"hide_phone"`
+	HideCity   *bool  `json:"hide_city"`
+	HideResume *bool  `json:"hide_resume"`
+}
+
+type PublicProfileSettings struct {
+	Slug       string `json:"slug"`
+	Visibility string `json:"visibility"`
+	HidePhone  bool   `json:"hide_phone"`
+	HideCity   bool   `json:"hide_city"`
+	HideResume bool   `json:"hide_resume"`
+	Path       string `json:"path,omitempty"` // where the profile can be viewed, unless private
+}
+
+// PublicProfile is the read-only view of a job seeker behind their public
+// link. Email is never included; phone, city and resume only when the seeker
+// hasn't hidden them, and portfolio projects only while portfolio sharing is on.
+type PublicProfile struct {
+	Slug              string                     `json:"slug"`
+	FullName          string                     `json:"full_name"`
+	AvatarURL         string                     `json:"avatar_url"`
+	JobSeekerType     string                     `json:"job_seeker_type"`
+	DesiredField      string                     `json:"desired_field"`
+	CurrentCity       string                     `json:"current_city,omitempty"`
+	Phone             string                     `json:"phone,omitempty"`
+	ResumeURL         string                     `json:"resume_url,omitempty"`
+	PortfolioURL      string                     `json:"portfolio_url,omitempty"`
+	Skills            []string                   `json:"skills"`
+	Student           *PublicStudentProfile      `json:"student_profile,omitempty"`
+	Professional      *PublicProfessionalProfile `json:"professional_profile,omitempty"`
+	Freelancer        *PublicFreelancerProfile   `json:"freelancer_profile,omitempty"`
+	PortfolioProjects []models.PortfolioProject  `json:"portfolio_projects"`
+	Indexable         bool                       `json:"-"` // false for unlisted profiles
+}
+
+type PublicStudentProfile struct {
+	CollegeName         string                       `json:"college_name"`
+	Degree              string                       `json:"degree"`
+	YearSemester        string                       `json:"year_semester"`
+	SoftwareProficiency []string                     `json:"software_proficiency"`
+	PreferredStartMonth string                       `json:"preferred_start_month,omitempty"`
+	PreferredDuration   string                       `json:"preferred_duration,omitempty"`
+	WillingToRelocate   bool                         `json:"willing_to_relocate"`
+	Internships         []models.StudentProfileEntry `json:"internships"`
+	FreelanceProjects   []models.StudentProfileEntry `json:"freelance_projects"`
+}
+
+type PublicProfessionalProfile struct {
+	CurrentTitle          string                    `json:"current_title,omitempty"`
+	YearsOfExperience     int                       `json:"years_of_experience"`
+	CoARegistrationNumber string                    `json:"coa_registration_number,omitempty"`
+	OtherLicenses         []string                  `json:"other_licenses"`
+	WorkHistory           []models.WorkHistoryEntry `json:"work_history"`
+}
+
+type PublicFreelancerProfile struct {
+	Availability string   `json:"availability"`
+	HoursPerWeek int      `json:"hours_per_week,omitempty"`
+	HourlyRate   int      `json:"hourly_rate,omitempty"`
+	ProjectRate  string   `json:"project_rate,omitempty"`
+	Services     []string `json:"services"`
+	Clients      []string `json:"clients"`
+}
+
+type PublicProfileService interface {
+	GetSettings(jobSeekerID uint) (*PublicProfileSettings, error)
+	UpdateSettings(jobSeekerID uint, req PublicProfileSettingsRequest) (*PublicProfileSettings, error)
+	GetPublicProfile(slug string) (*PublicProfile, error)
+}
+
+type publicProfileService struct {
+	jobSeekerRepo repositories.JobSeekerRepository
+}
+
+func NewPublicProfileService(jobSeekerRepo repositories.JobSeekerRepository) PublicProfileService {
+	return &publicProfileService{
+		jobSeekerRepo: jobSeekerRepo,
+	}
+}
+
+func (s *publicProfileService) GetSettings(jobSeekerID uint) (*PublicProfileSettings, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	return newPublicProfileSettings(jobSeeker), nil
+}
+
+func (s *publicProfileService) UpdateSettings(jobSeekerID uint, req PublicProfileSettingsRequest) (*PublicProfileSettings, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetByID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("job seeker profile not found")
+	}
+
+	if req.Slug != "" {
+		if utils.Slugify(req.Slug) != req.Slug {
+			return nil, errors.New("slug can only contain lowercase letters, numbers and single hyphens")
+		}
+		taken, err := s.jobSeekerRepo.ProfileSlugExists(req.Slug, jobSeeker.ID)
+		if err != nil {
+			return nil, err
+		}
+		if taken {
+			return nil, errors.New("slug is already taken")
+		}
+		jobSeeker.ProfileSlug = &req.Slug
+	}
+
+	if req.Visibility != "" {
+		jobSeeker.ProfileVisibility = req.Visibility
+	}
+	if req.HidePhone != nil {
+		jobSeeker.HidePhone = *req.HidePhone
+	}
+	if req.HideCity != nil {
+		jobSeeker.HideCity = *req.HideCity
+	}
+	if req.HideResume != nil {
+		jobSeeker.HideResume = *req.HideResume
+	}
+
+	// A profile being shared needs a link; derive one from the name
+	if jobSeeker.ProfileVisibility != ProfileVisibilityPrivate && jobSeeker.ProfileSlug == nil {
+		slug, err := s.uniqueProfileSlug(jobSeeker)
+		if err != nil {
+			return nil, err
+		}
+		jobSeeker.ProfileSlug = &slug
+	}
+
+	if err := s.jobSeekerRepo.Update(jobSeeker); err != nil {
+		return nil, errors.New("failed to update public profile settings")
+	}
+
+	return newPublicProfileSettings(jobSeeker), nil
+}
+
+func (s *publicProfileService) GetPublicProfile(slug string) (*PublicProfile, error) {
+	jobSeeker, err := s.jobSeekerRepo.GetByProfileSlug(slug)
+	// Private profiles look the same as ones that don't exist
+	if err != nil || jobSeeker.ProfileVisibility == ProfileVisibilityPrivate {
+		return nil, errors.New("profile not found")
+	}
+
+	return NewPublicProfile(jobSeeker), nil
+}
+
+// uniqueProfileSlug numbers the name's slug when another seeker already uses it
+func (s *publicProfileService) uniqueProfileSlug(jobSeeker *models.JobSeeker) (string, error) {
+	base := utils.Slugify(jobSeeker.FullName)
+	if len(base) < 3 {
+		base = fmt.Sprintf("profile-%d", jobSeeker.ID)
+	}
+
+	slug := base
+	for i := 2; ; i++ {
+		taken, err := s.jobSeekerRepo.ProfileSlugExists(slug, jobSeeker.ID)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+func newPublicProfileSettings(jobSeeker *models.JobSeeker) *PublicProfileSettings {
+	settings := &PublicProfileSettings{
+		Visibility: jobSeeker.ProfileVisibility,
+		HidePhone:  jobSeeker.HidePhone,
+		HideCity:   jobSeeker.HideCity,
+		HideResume: jobSeeker.HideResume,
+	}
+	if jobSeeker.ProfileSlug != nil {
+		settings.Slug = *jobSeeker.ProfileSlug
+		if settings.Visibility != ProfileVisibilityPrivate {
+			settings.Path = "/api/profiles/" + settings.Slug
+		}
+	}
+	return settings
+}
+
+// NewPublicProfile projects the job seeker, with extensions and portfolio
+// loaded, onto what their public profile may show
+func NewPublicProfile(jobSeeker *models.JobSeeker) *PublicProfile {
+	profile := &PublicProfile{
+		FullName:          jobSeeker.FullName,
+		AvatarURL:         models.AvatarPath(jobSeeker.UserID),
+		JobSeekerType:     jobSeeker.JobSeekerType,
+		DesiredField:      jobSeeker.DesiredField,
+		PortfolioURL:      jobSeeker.PortfolioURL,
+		Skills:            utils.JSONToArray(jobSeeker.Skills),
+		PortfolioProjects: []models.PortfolioProject{},
+		Indexable:         jobSeeker.ProfileVisibility == ProfileVisibilityPublic,
+	}
+	if jobSeeker.ProfileSlug != nil {
+		profile.Slug = *jobSeeker.ProfileSlug
+	}
+	// Projects are shown only while portfolio sharing is on, so turning it
+	// off hides them here as well as at the share link
+	if jobSeeker.PortfolioShareToken != nil && jobSeeker.PortfolioProjects != nil {
+		profile.PortfolioProjects = jobSeeker.PortfolioProjects
+	}
+
+	if !jobSeeker.HideCity {
+		profile.CurrentCity = jobSeeker.CurrentCity
+	}
+	if !jobSeeker.HidePhone {
+		profile.Phone = jobSeeker.Phone
+	}
+	if !jobSeeker.HideResume {
+		profile.ResumeURL = jobSeeker.ResumeURL
+	}
+
+	if student := jobSeeker.StudentProfile; student != nil {
+		profile.Student = &PublicStudentProfile{
+			CollegeName:         student.CollegeName,
+			Degree:              student.Degree,
+			YearSemester:        student.YearSemester,
+			SoftwareProficiency: utils.JSONToArray(student.SoftwareProficiency),
+			PreferredStartMonth: student.PreferredStartMonth,
+			PreferredDuration:   student.PreferredDuration,
+			WillingToRelocate:   student.WillingToRelocate,
+			Internships:         student.Internships,
+			FreelanceProjects:   student.FreelanceProjects,
+		}
+	}
+
+	if professional := jobSeeker.ProfessionalProfile; professional != nil {
+		profile.Professional = &PublicProfessionalProfile{
+			CurrentTitle:          professional.CurrentTitle,
+			YearsOfExperience:     professional.YearsOfExperience,
+			CoARegistrationNumber: professional.CoARegistrationNumber,
+			OtherLicenses:         utils.JSONToArray(professional.OtherLicenses),
+			WorkHistory:           professional.WorkHistory,
+		}
+	}
+
+	if freelancer := jobSeeker.FreelancerProfile; freelancer != nil {
+		profile.Freelancer = &PublicFreelancerProfile{
+			Availability: freelancer.Availability,
+			HoursPerWeek: freelancer.HoursPerWeek,
+			HourlyRate:   freelancer.HourlyRate,
+			ProjectRate:  freelancer.ProjectRate,
+			Services:     utils.JSONToArray(freelancer.Services),
+			Clients:      utils.JSONToArray(freelancer.Clients),
+		}
+	}
+
+	return profile
+}
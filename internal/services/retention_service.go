@@ -0,0 +1,101 @@
+package services
+
+import (
+	"log"
+	"time"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+)
+
+type PurgeResult struct {
+	Applications         int64 `json:"applications"`
+	StudentProfiles      int64 `json:"student_profiles"`
+	ProfessionalProfiles int64 `json:"professional_profiles"`
+	FreelancerProfiles   int64 `json:"freelancer_profiles"`
+	FirmProfiles         int64 `json:"firm_profiles"`
+	Jobs                 int64 `json:"jobs"`
+}
+
+// RetentionService permanently removes soft-deleted records once they are
+// older than the configured retention window.
+type RetentionService interface {
+	PurgeExpired() (*PurgeResult, error)
+	Start(interval time.Duration)
+}
+
+type retentionService struct {
+	retention               time.Duration
+	jobRepo                 repositories.JobRepository
+	applicationRepo         repositories.ApplicationRepository
+	studentProfileRepo      repositories.StudentProfileRepository
+	professionalProfileRepo repositories.ProfessionalProfileRepository
+	freelancerProfileRepo   repositories.FreelancerProfileRepository
+	firmProfileRepo         repositories.FirmProfileRepository
+}
+
+func NewRetentionService(
+	retentionDays int,
+	jobRepo repositories.JobRepository,
+	applicationRepo repositories.ApplicationRepository,
+	studentProfileRepo repositories.StudentProfileRepository,
+	professionalProfileRepo repositories.ProfessionalProfileRepository,
+	freelancerProfileRepo repositories.FreelancerProfileRepository,
+	firmProfileRepo repositories.FirmProfileRepository,
+) RetentionService {
+	return &retentionService{
+		retention:               time.Duration(retentionDays) * 24 * time.Hour,
+		jobRepo:                 jobRepo,
+		applicationRepo:         applicationRepo,
+		studentProfileRepo:      studentProfileRepo,
+		professionalProfileRepo: professionalProfileRepo,
+		freelancerProfileRepo:   freelancerProfileRepo,
+		firmProfileRepo:         firmProfileRepo,
+	}
+}
+
+func (s *retentionService) PurgeExpired() (*PurgeResult, error) {
+	cutoff := time.Now().Add(-s.retention)
+	result := &PurgeResult{}
+
+	var err error
+	// Applications go first so that jobs left without history can be purged too
+	if result.Applications, err = s.applicationRepo.PurgeDeletedBefore(cutoff); err != nil {
+		return result, err
+	}
+	if result.StudentProfiles, err = s.studentProfileRepo.PurgeDeletedBefore(cutoff); err != nil {
+		return result, err
+	}
+	if result.ProfessionalProfiles, err = s.professionalProfileRepo.PurgeDeletedBefore(cutoff); err != nil {
+		return result, err
+	}
+	if result.FreelancerProfiles, err = s.freelancerProfileRepo.PurgeDeletedBefore(cutoff); err != nil {
+		return result, err
+	}
+	if result.FirmProfiles, err = s.firmProfileRepo.PurgeDeletedBefore(cutoff); err != nil {
+		return result, err
+	}
+	if result.Jobs, err = s.jobRepo.PurgeDeletedBefore(cutoff); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// Start runs the purge in the background on the given interval
+func (s *retentionService) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			result, err := s.PurgeExpired()
+			if err != nil {
+				log.Println("Retention purge failed:", err)
+			} else {
+				log.Printf("🧹 Retention purge removed %d applications, %d student profiles, %d professional profiles, %d freelancer profiles, %d firm profiles, %d jobs",
+					result.Applications, result.StudentProfiles, result.ProfessionalProfiles, result.FreelancerProfiles, result.FirmProfiles, result.Jobs)
+			}
+			<-ticker.C
+		}
+	}()
+}
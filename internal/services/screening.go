@@ -0,0 +1,233 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+const maxScreeningAnswerLength = 2000
+
+type ScreeningQuestionInput struct {
+	Prompt          string   `json:"prompt" binding:"required"`
+	QuestionType    string   `json:"question_type" binding:"required,oneof=text yes_no multiple_choice numeric"`
+	Options         []string `json:"options"`
+	IsRequired      bool     `json:"is_required"`
+	IsKnockout      bool     `json:"is_knockout"`
+	AcceptedAnswers []string `json:"accepted_answers"`
+	MinValue        *float64 `json:"min_value"`
+	MaxValue        *float64 `json:"max_value"`
+}
+
+type ScreeningAnswerInput struct {
+	QuestionID uint   `json:"question_id" binding:"required"`
+	Answer     string `json:"answer"`
+}
+
+// buildScreeningQuestions validates the employer's questions and knockout
+// rules and converts them into models ready to be attached to a job.
+func buildScreeningQuestions(inputs []ScreeningQuestionInput) ([]models.ScreeningQuestion, error) {
+	questions := make([]models.ScreeningQuestion, 0, len(inputs))
+	for i, input := range inputs {
+		if strings.TrimSpace(input.Prompt) == "" {
+			return nil, fmt.Errorf("screening question %d: prompt is required", i+1)
+		}
+
+		accepted := input.AcceptedAnswers
+		switch input.QuestionType {
+		case "text":
+			if input.IsKnockout {
+				return nil, fmt.Errorf("screening question %d: text questions cannot be knockout questions", i+1)
+			}
+		case "yes_no":
+			for j, answer := range accepted {
+				normalized, ok := normalizeYesNo(answer)
+				if !ok {
+					return nil, fmt.Errorf("screening question %d: accepted answers must be yes or no", i+1)
+				}
+				accepted[j] = normalized
+			}
+			if input.IsKnockout && len(accepted) == 0 {
+				return nil, fmt.Errorf("screening question %d: knockout questions need accepted answers", i+1)
+			}
+		case "multiple_choice":
+			if len(input.Options) < 2 {
+				return nil, fmt.Errorf("screening question %d: multiple choice questions need at least two options", i+1)
+			}
+			for j, answer := range accepted {
+				option, ok := matchOption(input.Options, answer)
+				if !ok {
+					return nil, fmt.Errorf("screening question %d: accepted answer %q is not one of the options", i+1, answer)
+				}
+				accepted[j] = option
+			}
+			if input.IsKnockout && len(accepted) == 0 {
+				return nil, fmt.Errorf("screening question %d: knockout questions need accepted answers", i+1)
+			}
+		case "numeric":
+			if input.MinValue != nil && input.MaxValue != nil && *input.MinValue > *input.MaxValue {
+				return nil, fmt.Errorf("screening question %d: min value cannot be greater than max value", i+1)
+			}
+			if input.IsKnockout && input.MinValue == nil && input.MaxValue == nil {
+				return nil, fmt.Errorf("screening question %d: knockout questions need a min or max value", i+1)
+			}
+		default:
+			return nil, fmt.Errorf("screening question %d: unsupported question type %q", i+1, input.QuestionType)
+		}
+
+		question := models.ScreeningQuestion{
+			Position:        i,
+			Prompt:          input.Prompt,
+			QuestionType:    input.QuestionType,
+			Options:         utils.ArrayToJSON(nil),
+			IsRequired:      input.IsRequired,
+			IsKnockout:      input.IsKnockout,
+			AcceptedAnswers: utils.ArrayToJSON(nil),
+		}
+		if input.QuestionType == "multiple_choice" {
+			question.Options = utils.ArrayToJSON(input.Options)
+		}
+		if input.IsKnockout {
+			question.AcceptedAnswers = utils.ArrayToJSON(accepted)
+			question.MinValue = input.MinValue
+			question.MaxValue = input.MaxValue
+		}
+		questions = append(questions, question)
+	}
+
+	return questions, nil
+}
+
+// EvaluateScreeningAnswers validates a candidate's answers against the job's
+// screening questions. It returns the answers to store and whether any
+// knockout rule failed.
+func EvaluateScreeningAnswers(questions []models.ScreeningQuestion, inputs []ScreeningAnswerInput) ([]models.ApplicationAnswer, bool, error) {
+	answersByQuestion := map[uint]string{}
+	for _, input := range inputs {
+		if _, duplicate := answersByQuestion[input.QuestionID]; duplicate {
+			return nil, false, errors.New("each screening question can only be answered once")
+		}
+		answersByQuestion[input.QuestionID] = strings.TrimSpace(input.Answer)
+	}
+
+	knownQuestions := map[uint]bool{}
+	answers := []models.ApplicationAnswer{}
+	knockedOut := false
+
+	for _, question := range questions {
+		knownQuestions[question.ID] = true
+		answer := answersByQuestion[question.ID]
+
+		// Knockout questions can't be skipped, otherwise the rule is meaningless
+		if answer == "" {
+			if question.IsRequired || question.IsKnockout {
+				return nil, false, fmt.Errorf("an answer is required for: %s", question.Prompt)
+			}
+			continue
+		}
+
+		if len(answer) > maxScreeningAnswerLength {
+			return nil, false, fmt.Errorf("answer is too long for: %s", question.Prompt)
+		}
+
+		normalized, passed, err := evaluateAnswer(question, answer)
+		if err != nil {
+			return nil, false, err
+		}
+		if !passed {
+			knockedOut = true
+		}
+
+		answers = append(answers, models.ApplicationAnswer{
+			ScreeningQuestionID: question.ID,
+			Answer:              normalized,
+			Passed:              passed,
+		})
+	}
+
+	for questionID := range answersByQuestion {
+		if !knownQuestions[questionID] {
+			return nil, false, fmt.Errorf("screening question %d does not belong to this job", questionID)
+		}
+	}
+
+	return answers, knockedOut, nil
+}
+
+func evaluateAnswer(question models.ScreeningQuestion, answer string) (string, bool, error) {
+	accepted := utils.JSONToArray(question.AcceptedAnswers)
+
+	switch question.QuestionType {
+	case "yes_no":
+		normalized, ok := normalizeYesNo(answer)
+		if !ok {
+			return "", false, fmt.Errorf("answer must be yes or no for: %s", question.Prompt)
+		}
+		return normalized, !question.IsKnockout || containsFold(accepted, normalized), nil
+	case "multiple_choice":
+		option, ok := matchOption(utils.JSONToArray(question.Options), answer)
+		if !ok {
+			return "", false, fmt.Errorf("answer must be one of the listed options for: %s", question.Prompt)
+		}
+		return option, !question.IsKnockout || containsFold(accepted, option), nil
+	case "numeric":
+		value, err := strconv.ParseFloat(answer, 64)
+		if err != nil {
+			return "", false, fmt.Errorf("answer must be a number for: %s", question.Prompt)
+		}
+		passed := true
+		if question.IsKnockout {
+			if question.MinValue != nil && value < *question.MinValue {
+				passed = false
+			}
+			if question.MaxValue != nil && value > *question.MaxValue {
+				passed = false
+			}
+		}
+		return answer, passed, nil
+	default:
+		return answer, true, nil
+	}
+}
+
+// hideKnockoutRules strips the accepted answers from questions shown to
+// candidates so the rules can't be gamed.
+func hideKnockoutRules(questions []models.ScreeningQuestion) {
+	for i := range questions {
+		questions[i].AcceptedAnswers = ""
+		questions[i].MinValue = nil
+		questions[i].MaxValue = nil
+	}
+}
+
+func normalizeYesNo(answer string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "yes", "y", "true":
+		return "yes", true
+	case "no", "n", "false":
+		return "no", true
+	}
+	return "", false
+}
+
+func matchOption(options []string, answer string) (string, bool) {
+	for _, option := range options {
+		if strings.EqualFold(strings.TrimSpace(option), strings.TrimSpace(answer)) {
+			return option, true
+		}
+	}
+	return "", false
+}
+
+func containsFold(values []string, target string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"mime/multipart"
 	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -20,7 +21,7 @@ func NewMockS3Service() StorageService {
 }
 
 func (s *mockS3Service) UploadFile(userID uint, fileType FileType, file *multipart.FileHeader) (string, error) {
-	timestamp := time.Now().Unix()
+	timestamp := time.Now().UnixNano() // several files can be uploaded in the same second
 	ext := filepath.Ext(file.Filename)
 	filename := fmt.Sprintf("user_%d_%s_%d%s", userID, fileType, timestamp, ext)
 	
@@ -30,6 +31,20 @@ func (s *mockS3Service) UploadFile(userID uint, fileType FileType, file *multipa
 	return url, nil
 }
 
+func (s *mockS3Service) UploadBytes(userID uint, fileType FileType, name string, data []byte) (string, error) {
+	timestamp := time.Now().UnixNano()
+	filename := fmt.Sprintf("user_%d_%s_%d_%s", userID, fileType, timestamp, name)
+
+	url := fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s/%s",
+		s.bucketName, s.region, fileType, filename)
+
+	return url, nil
+}
+
+func (s *mockS3Service) OwnsURL(url string) bool {
+	return strings.HasPrefix(url, fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", s.bucketName, s.region))
+}
+
 func (s *mockS3Service) DeleteFile(url string) error {
 	return nil
 }
@@ -59,6 +74,14 @@ func (s *realS3Service) UploadFile(userID uint, fileType FileType, file *multipa
 	return "", fmt.Errorf("real S3 implementation not yet available")
 }
 
+func (s *realS3Service) UploadBytes(userID uint, fileType FileType, name string, data []byte) (string, error) {
+	return "", fmt.Errorf("real S3 implementation not yet available")
+}
+
+func (s *realS3Service) OwnsURL(url string) bool {
+	return strings.HasPrefix(url, fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", s.bucketName, s.region))
+}
+
 func (s *realS3Service) DeleteFile(url string) error {
 	return fmt.Errorf("real S3 implementation not yet available")
 }
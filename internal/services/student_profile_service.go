@@ -2,6 +2,8 @@ package services
 
 import (
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
@@ -15,8 +17,6 @@ type CreateStudentProfileRequest struct {
 	Degree              string   `json:"degree" binding:"required"`
 	YearSemester        string   `json:"year_semester" binding:"required"`
 	SoftwareProficiency []string `json:"software_proficiency"`
-	PreviousInternships []string `json:"previous_internships"`
-	FreelanceProjects   []string `json:"freelance_projects"`
 	PreferredStartMonth string   `json:"preferred_start_month"`
 	PreferredDuration   string   `json:"preferred_duration"`
 	WillingToRelocate   bool     `json:"willing_to_relocate"`
@@ -27,18 +27,33 @@ type UpdateStudentProfileRequest struct {
 	Degree              string   `json:"degree"`
 	YearSemester        string   `json:"year_semester"`
 	SoftwareProficiency []string `json:"software_proficiency"`
-	PreviousInternships []string `json:"previous_internships"`
-	FreelanceProjects   []string `json:"freelance_projects"`
 	PreferredStartMonth string   `json:"preferred_start_month"`
 	PreferredDuration   string   `json:"preferred_duration"`
 	WillingToRelocate   *bool    `json:"willing_to_relocate"`
 }
 
+// StudentProfileEntryRequest adds or replaces an internship or freelance
+// project. Leave end_date empty for ongoing work.
+type StudentProfileEntryRequest struct {
+	Organization string   `json:"organization" binding:"required,max=255"`
+	Role         string   `json:"role" binding:"required,max=255"`
+	StartDate    string   `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate      string   `json:"end_date" binding:"omitempty,datetime=2006-01-02"`
+	Description  string   `json:"description" binding:"max=5000"`
+	Links        []string `json:"links" binding:"omitempty,max=10,dive,url"`
+	Media        []string `json:"media" binding:"omitempty,max=20,dive,url"`
+}
+
 type StudentProfileService interface {
 	CreateProfile(jobSeekerID uint, req CreateStudentProfileRequest) (*models.StudentProfile, error)
 	GetProfile(jobSeekerID uint) (*models.StudentProfile, error)
 	UpdateProfile(jobSeekerID uint, req UpdateStudentProfileRequest) (*models.StudentProfile, error)
 	DeleteProfile(jobSeekerID uint) error
+	RestoreProfile(jobSeekerID uint) (*models.StudentProfile, error)
+	GetEntries(jobSeekerID uint, entryType string) ([]models.StudentProfileEntry, error)
+	AddEntry(jobSeekerID uint, entryType string, req StudentProfileEntryRequest) (*models.StudentProfileEntry, error)
+	UpdateEntry(jobSeekerID uint, entryType string, entryID uint, req StudentProfileEntryRequest) (*models.StudentProfileEntry, error)
+	DeleteEntry(jobSeekerID uint, entryType string, entryID uint) error
 }
 
 type studentProfileService struct {
@@ -76,8 +91,6 @@ func (s *studentProfileService) CreateProfile(jobSeekerID uint, req CreateStuden
 
 	// Convert arrays to JSON strings
 	softwareJSON := utils.ArrayToJSON(req.SoftwareProficiency)
-	internshipsJSON := utils.ArrayToJSON(req.PreviousInternships)
-	projectsJSON := utils.ArrayToJSON(req.FreelanceProjects)
 
 	// Create student profile
 	profile := &models.StudentProfile{
@@ -86,8 +99,6 @@ func (s *studentProfileService) CreateProfile(jobSeekerID uint, req CreateStuden
 		Degree:              req.Degree,
 		YearSemester:        req.YearSemester,
 		SoftwareProficiency: softwareJSON,
-		PreviousInternships: internshipsJSON,
-		FreelanceProjects:   projectsJSON,
 		PreferredStartMonth: req.PreferredStartMonth,
 		PreferredDuration:   req.PreferredDuration,
 		WillingToRelocate:   req.WillingToRelocate,
@@ -139,12 +150,6 @@ func (s *studentProfileService) UpdateProfile(jobSeekerID uint, req UpdateStuden
 	if len(req.SoftwareProficiency) > 0 {
 		profile.SoftwareProficiency = utils.ArrayToJSON(req.SoftwareProficiency)
 	}
-	if len(req.PreviousInternships) > 0 {
-		profile.PreviousInternships = utils.ArrayToJSON(req.PreviousInternships)
-	}
-	if len(req.FreelanceProjects) > 0 {
-		profile.FreelanceProjects = utils.ArrayToJSON(req.FreelanceProjects)
-	}
 	if req.PreferredStartMonth != "" {
 		profile.PreferredStartMonth = req.PreferredStartMonth
 	}
@@ -172,3 +177,119 @@ func (s *studentProfileService) DeleteProfile(jobSeekerID uint) error {
 	return s.studentProfileRepo.Delete(jobSeekerID)
 }
 
+func (s *studentProfileService) RestoreProfile(jobSeekerID uint) (*models.StudentProfile, error) {
+	existingProfile, err := s.studentProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err == nil && existingProfile != nil {
+		return nil, errors.New("student profile already exists")
+	}
+
+	deletedProfile, err := s.studentProfileRepo.GetDeletedByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("deleted student profile not found")
+	}
+
+	if err := s.studentProfileRepo.Restore(deletedProfile.ID); err != nil {
+		return nil, errors.New("failed to restore student profile")
+	}
+
+	return s.studentProfileRepo.GetByJobSeekerID(jobSeekerID)
+}
+
+func (s *studentProfileService) GetEntries(jobSeekerID uint, entryType string) ([]models.StudentProfileEntry, error) {
+	profile, err := s.studentProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("student profile not found")
+	}
+
+	return s.studentProfileRepo.GetEntries(profile.ID, entryType)
+}
+
+func (s *studentProfileService) AddEntry(jobSeekerID uint, entryType string, req StudentProfileEntryRequest) (*models.StudentProfileEntry, error) {
+	profile, err := s.studentProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("student profile not found")
+	}
+
+	entry := &models.StudentProfileEntry{
+		StudentProfileID: profile.ID,
+		EntryType:        entryType,
+	}
+	if err := applyEntryRequest(entry, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.studentProfileRepo.CreateEntry(entry); err != nil {
+		return nil, errors.New("failed to add entry")
+	}
+
+	return entry, nil
+}
+
+func (s *studentProfileService) UpdateEntry(jobSeekerID uint, entryType string, entryID uint, req StudentProfileEntryRequest) (*models.StudentProfileEntry, error) {
+	entry, err := s.getEntry(jobSeekerID, entryType, entryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyEntryRequest(entry, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.studentProfileRepo.UpdateEntry(entry); err != nil {
+		return nil, errors.New("failed to update entry")
+	}
+
+	return entry, nil
+}
+
+func (s *studentProfileService) DeleteEntry(jobSeekerID uint, entryType string, entryID uint) error {
+	entry, err := s.getEntry(jobSeekerID, entryType, entryID)
+	if err != nil {
+		return err
+	}
+
+	return s.studentProfileRepo.DeleteEntry(entry.ID)
+}
+
+// getEntry loads an entry of the given type from the job seeker's own profile
+func (s *studentProfileService) getEntry(jobSeekerID uint, entryType string, entryID uint) (*models.StudentProfileEntry, error) {
+	profile, err := s.studentProfileRepo.GetByJobSeekerID(jobSeekerID)
+	if err != nil {
+		return nil, errors.New("student profile not found")
+	}
+
+	entry, err := s.studentProfileRepo.GetEntry(profile.ID, entryType, entryID)
+	if err != nil {
+		return nil, errors.New("entry not found")
+	}
+
+	return entry, nil
+}
+
+func applyEntryRequest(entry *models.StudentProfileEntry, req StudentProfileEntryRequest) error {
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return errors.New("start date must be in YYYY-MM-DD format")
+	}
+
+	var endDate *time.Time
+	if req.EndDate != "" {
+		end, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return errors.New("end date must be in YYYY-MM-DD format")
+		}
+		if end.Before(startDate) {
+			return errors.New("end date cannot be before start date")
+		}
+		endDate = &end
+	}
+
+	entry.Organization = strings.TrimSpace(req.Organization)
+	entry.Role = strings.TrimSpace(req.Role)
+	entry.StartDate = &startDate
+	entry.EndDate = endDate
+	entry.Description = strings.TrimSpace(req.Description)
+	entry.Links = utils.ArrayToJSON(req.Links)
+	entry.Media = utils.ArrayToJSON(req.Media)
+	return nil
+}
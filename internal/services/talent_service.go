@@ -0,0 +1,137 @@
+package services
+
+import (
+	"strings"
+
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/repositories"
+	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
+)
+
+const (
+	TalentVisibilityFull      = "full"
+	TalentVisibilityAnonymous = "anonymous"
+)
+
+type TalentFilters struct {
+	Skills       string `form:"skills"`   // comma separated, candidates must have all of them
+	Software     string `form:"software"` // comma separated software proficiency
+	DesiredField string `form:"desired_field"`
+	City         string `form:"city"`
+	SeekerType   string `form:"seeker_type" binding:"omitempty,oneof=student professional freelancer"`
+	College      string `form:"college"`
+	Degree       string `form:"degree"`
+	Page         int    `form:"page" binding:"omitempty,min=1"`
+	PageSize     int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// TalentProfile is what employers see of a candidate in talent search.
+// Phone and email are never included; employers reach candidates through
+// invitations. Anonymous candidates also hide their name, avatar, resume and
+// portfolio.
+type TalentProfile struct {
+	JobSeekerID         uint     `json:"job_seeker_id"`
+	FullName            string   `json:"full_name,omitempty"`
+	AvatarURL           string   `json:"avatar_url,omitempty"`
+	Anonymous           bool     `json:"anonymous"`
+	JobSeekerType       string   `json:"job_seeker_type"`
+	CurrentCity         string   `json:"current_city"`
+	DesiredField        string   `json:"desired_field"`
+	Skills              []string `json:"skills"`
+	ResumeURL           string   `json:"resume_url,omitempty"`
+	PortfolioURL        string   `json:"portfolio_url,omitempty"`
+	CollegeName         string   `json:"college_name,omitempty"`
+	Degree              string   `json:"degree,omitempty"`
+	YearSemester        string   `json:"year_semester,omitempty"`
+	SoftwareProficiency []string `json:"software_proficiency"`
+	PreferredStartMonth string   `json:"preferred_start_month,omitempty"`
+	WillingToRelocate   bool     `json:"willing_to_relocate"`
+}
+
+type TalentService interface {
+	SearchTalent(filters TalentFilters) ([]TalentProfile, *Pagination, error)
+}
+
+type talentService struct {
+	jobSeekerRepo repositories.JobSeekerRepository
+}
+
+func NewTalentService(jobSeekerRepo repositories.JobSeekerRepository) TalentService {
+	return &talentService{
+		jobSeekerRepo: jobSeekerRepo,
+	}
+}
+
+func (s *talentService) SearchTalent(filters TalentFilters) ([]TalentProfile, *Pagination, error) {
+	page := filters.Page
+	if page == 0 {
+		page = 1
+	}
+	pageSize := filters.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	jobSeekers, total, err := s.jobSeekerRepo.SearchTalent(repositories.TalentFilters{
+		Skills:       splitCommaList(filters.Skills),
+		Software:     splitCommaList(filters.Software),
+		DesiredField: strings.TrimSpace(filters.DesiredField),
+		City:         strings.TrimSpace(filters.City),
+		SeekerType:   filters.SeekerType,
+		College:      strings.TrimSpace(filters.College),
+		Degree:       strings.TrimSpace(filters.Degree),
+		Offset:       (page - 1) * pageSize,
+		Limit:        pageSize,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profiles := make([]TalentProfile, 0, len(jobSeekers))
+	for i := range jobSeekers {
+		profiles = append(profiles, newTalentProfile(&jobSeekers[i]))
+	}
+
+	return profiles, newPagination(page, pageSize, total), nil
+}
+
+func newTalentProfile(jobSeeker *models.JobSeeker) TalentProfile {
+	profile := TalentProfile{
+		JobSeekerID:         jobSeeker.ID,
+		Anonymous:           jobSeeker.TalentVisibility == TalentVisibilityAnonymous,
+		JobSeekerType:       jobSeeker.JobSeekerType,
+		CurrentCity:         jobSeeker.CurrentCity,
+		DesiredField:        jobSeeker.DesiredField,
+		Skills:              utils.JSONToArray(jobSeeker.Skills),
+		SoftwareProficiency: []string{},
+	}
+
+	if !profile.Anonymous {
+		profile.FullName = jobSeeker.FullName
+		profile.AvatarURL = jobSeeker.AvatarURL
+		profile.ResumeURL = jobSeeker.ResumeURL
+		profile.PortfolioURL = jobSeeker.PortfolioURL
+	}
+
+	if student := jobSeeker.StudentProfile; student != nil {
+		profile.CollegeName = student.CollegeName
+		profile.Degree = student.Degree
+		profile.YearSemester = student.YearSemester
+		profile.SoftwareProficiency = utils.JSONToArray(student.SoftwareProficiency)
+		profile.PreferredStartMonth = student.PreferredStartMonth
+		profile.WillingToRelocate = student.WillingToRelocate
+	}
+
+	return profile
+}
+
+// splitCommaList parses a comma separated query value, dropping blanks
+func splitCommaList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -9,10 +9,18 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// StreamTokenPurpose marks tokens that only open the notification stream
+const StreamTokenPurpose = "stream"
+
+// streamTokenLifetime only has to cover opening the stream; an open stream
+// stays connected after its token expires
+const streamTokenLifetime = 2 * time.Minute
+
 type Claims struct {
 	UserID   uint   `json:"user_id"`
 	Email    string `json:"email"`
 	UserType string `json:"user_type"`
+	Purpose  string `json:"purpose,omitempty"` // empty for regular login tokens
 	jwt.RegisteredClaims
 }
 
@@ -27,6 +35,17 @@ func CheckPassword(password, hash string) bool {
 }
 
 func GenerateJWT(userID uint, email, userType string) (string, error) {
+	return signJWT(userID, email, userType, "", 24*time.Hour)
+}
+
+// GenerateStreamToken issues a short-lived token that can only be used to
+// open the notification stream. EventSource can't send headers, so it goes
+// in the URL, where a login token would end up in access logs.
+func GenerateStreamToken(userID uint, email, userType string) (string, error) {
+	return signJWT(userID, email, userType, StreamTokenPurpose, streamTokenLifetime)
+}
+
+func signJWT(userID uint, email, userType, purpose string, lifetime time.Duration) (string, error) {
 	jwtSecret := os.Getenv("JWT_SECRET")
 	if jwtSecret == "" {
 		return "", errors.New("JWT_SECRET not set")
@@ -36,8 +55,9 @@ func GenerateJWT(userID uint, email, userType string) (string, error) {
 		UserID:   userID,
 		Email:    email,
 		UserType: userType,
+		Purpose:  purpose,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(lifetime)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "black-pages",
 		},
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"html"
+	"image"
+	"image/color"
+	"strings"
+	"unicode"
+)
+
+// avatarColors are the backgrounds for generated avatars, all dark enough
+// for white initials
+var avatarColors = []string{
+	"#1f2937", "#7c2d12", "#14532d", "#1e3a8a", "#581c87",
+	"#831843", "#134e4a", "#713f12", "#3f3f46", "#9f1239",
+}
+
+// Initials returns up to two uppercase initials: the first letters of the
+// first and last words of name
+func Initials(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "?"
+	}
+
+	initials := []rune{firstRune(words[0])}
+	if len(words) > 1 {
+		initials = append(initials, firstRune(words[len(words)-1]))
+	}
+	return strings.ToUpper(string(initials))
+}
+
+func firstRune(word string) rune {
+	for _, r := range word {
+		return r
+	}
+	return '?'
+}
+
+// InitialsAvatarSVG renders a square avatar showing the name's initials. The
+// background colour is derived from the name, so a person keeps the same
+// colour everywhere.
+func InitialsAvatarSVG(name string, size int) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(strings.ToLower(strings.TrimSpace(name))))
+	background := avatarColors[hash.Sum32()%uint32(len(avatarColors))]
+
+	return fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 100 100">`+
+			`<rect width="100" height="100" fill="%s"/>`+
+			`<text x="50" y="50" dy="0.35em" text-anchor="middle" fill="#ffffff" `+
+			`font-family="Helvetica, Arial, sans-serif" font-size="40" font-weight="600">%s</text>`+
+			`</svg>`,
+		size, size, background, html.EscapeString(Initials(name)),
+	)
+}
+
+// CropSquare cuts the largest centred square out of img and scales it to
+// size x size. Each output pixel averages the source pixels it covers, so
+// large photos shrink without aliasing. Transparent areas become white.
+func CropSquare(img image.Image, size int) *image.RGBA {
+	bounds := img.Bounds()
+	side := bounds.Dx()
+	if bounds.Dy() < side {
+		side = bounds.Dy()
+	}
+	left := bounds.Min.X + (bounds.Dx()-side)/2
+	top := bounds.Min.Y + (bounds.Dy()-side)/2
+
+	out := image.NewRGBA(image.Rect(0, 0, size, size))
+	for y := 0; y < size; y++ {
+		y0 := top + y*side/size
+		y1 := top + (y+1)*side/size
+		if y1 <= y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < size; x++ {
+			x0 := left + x*side/size
+			x1 := left + (x+1)*side/size
+			if x1 <= x0 {
+				x1 = x0 + 1
+			}
+			out.SetRGBA(x, y, averageOnWhite(img, x0, y0, x1, y1))
+		}
+	}
+	return out
+}
+
+// averageOnWhite averages the pixels in [x0,x1) x [y0,y1), composited over
+// a white background
+func averageOnWhite(img image.Image, x0, y0, x1, y1 int) color.RGBA {
+	var r, g, b, count uint64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			// Premultiplied alpha: adding the uncovered share of white
+			// composites the pixel over a white background
+			pr, pg, pb, pa := img.At(x, y).RGBA()
+			r += uint64(pr + 0xffff - pa)
+			g += uint64(pg + 0xffff - pa)
+			b += uint64(pb + 0xffff - pa)
+			count++
+		}
+	}
+	return color.RGBA{
+		R: uint8(r / count >> 8),
+		G: uint8(g / count >> 8),
+		B: uint8(b / count >> 8),
+		A: 0xff,
+	}
+}
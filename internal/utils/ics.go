@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const icsTimeFormat = "20060102T150405Z"
+
+// CalendarEvent is a single VEVENT in an iCalendar (RFC 5545) document
+type CalendarEvent struct {
+	UID         string
+	Sequence    int
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Cancelled   bool
+	UpdatedAt   time.Time
+}
+
+// BuildICS renders events as an iCalendar document. Times are written in
+// UTC so every calendar client converts them to the viewer's own timezone.
+func BuildICS(calendarName string, events []CalendarEvent) string {
+	var b strings.Builder
+
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//Black Pages//Interviews//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	if calendarName != "" {
+		writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText(calendarName))
+	}
+
+	for _, event := range events {
+		status := "CONFIRMED"
+		if event.Cancelled {
+			status = "CANCELLED"
+		}
+		stamp := event.UpdatedAt
+		if stamp.IsZero() {
+			stamp = time.Now()
+		}
+
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, "UID:"+event.UID)
+		writeICSLine(&b, fmt.Sprintf("SEQUENCE:%d", event.Sequence))
+		writeICSLine(&b, "DTSTAMP:"+stamp.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "DTSTART:"+event.Start.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "DTEND:"+event.End.UTC().Format(icsTimeFormat))
+		writeICSLine(&b, "SUMMARY:"+escapeICSText(event.Summary))
+		if event.Description != "" {
+			writeICSLine(&b, "DESCRIPTION:"+escapeICSText(event.Description))
+		}
+		if event.Location != "" {
+			writeICSLine(&b, "LOCATION:"+escapeICSText(event.Location))
+		}
+		if event.URL != "" {
+			writeICSLine(&b, "URL:"+event.URL)
+		}
+		writeICSLine(&b, "STATUS:"+status)
+		writeICSLine(&b, "END:VEVENT")
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// escapeICSText escapes the characters that have a meaning in TEXT values
+func escapeICSText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// writeICSLine writes a content line, folding it so no line is longer than
+// 75 octets. Continuation lines start with a single space.
+func writeICSLine(b *strings.Builder, line string) {
+	// The leading space of a continuation line counts towards its length
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		// Never split a multi-byte UTF-8 character
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
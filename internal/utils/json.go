@@ -1,5 +1,9 @@
 package utils
 
+import (
+	"encoding/json"
+)
+
 func ArrayToJSON(arr []string) string {
 	if len(arr) == 0 {
 		return "[]"
@@ -14,4 +18,16 @@ func ArrayToJSON(arr []string) string {
 	}
 	json += `"]`
 	return json
-}
\ No newline at end of file
+}
+
+func JSONToArray(value string) []string {
+	arr := []string{}
+	if value == "" {
+		return arr
+	}
+
+	if err := json.Unmarshal([]byte(value), &arr); err != nil {
+		return []string{}
+	}
+	return arr
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+const maxSlugLength = 100
+
+// Slugify turns a name into a lowercase, hyphen-separated URL segment.
+// Anything other than ASCII letters and digits is treated as a separator.
+func Slugify(value string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(value) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			pendingHyphen = false
+			continue
+		}
+		pendingHyphen = true
+	}
+
+	slug := b.String()
+	if len(slug) > maxSlugLength {
+		slug = strings.TrimRight(slug[:maxSlugLength], "-")
+	}
+	return slug
+}
@@ -0,0 +1,18 @@
+-- Allow employers to save incomplete job postings as drafts
+ALTER TABLE jobs ADD COLUMN is_draft BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Enum columns may stay blank while a job is still a draft
+ALTER TABLE jobs DROP CONSTRAINT jobs_job_type_check;
+ALTER TABLE jobs ADD CONSTRAINT jobs_job_type_check
+    CHECK (is_draft OR job_type IN ('internship', 'full_time', 'contract'));
+
+ALTER TABLE jobs DROP CONSTRAINT jobs_target_audience_check;
+ALTER TABLE jobs ADD CONSTRAINT jobs_target_audience_check
+    CHECK (is_draft OR target_audience IN ('students', 'professionals', 'any'));
+
+ALTER TABLE jobs DROP CONSTRAINT jobs_employment_mode_check;
+ALTER TABLE jobs ADD CONSTRAINT jobs_employment_mode_check
+    CHECK (is_draft OR employment_mode IN ('on_site', 'remote', 'hybrid'));
+
+-- Create indexes
+CREATE INDEX idx_jobs_is_draft ON jobs(is_draft);
@@ -0,0 +1,17 @@
+-- Create job_revisions table (audit trail of every change made to a job)
+CREATE TABLE job_revisions (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    changed_by_user_id INTEGER NOT NULL REFERENCES users(id),
+    changes JSON,
+    snapshot JSON NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(job_id, version)
+);
+
+-- Create indexes
+CREATE INDEX idx_job_revisions_job_id ON job_revisions(job_id);
+CREATE INDEX idx_job_revisions_created_at ON job_revisions(created_at);
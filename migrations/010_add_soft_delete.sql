@@ -0,0 +1,29 @@
+-- Soft delete support for jobs, applications and profile extensions
+ALTER TABLE jobs ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE applications ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE student_profiles ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE firm_profiles ADD COLUMN deleted_at TIMESTAMP;
+
+-- Removing a job must never silently wipe candidate history
+ALTER TABLE applications DROP CONSTRAINT applications_job_id_fkey;
+ALTER TABLE applications ADD CONSTRAINT applications_job_id_fkey
+    FOREIGN KEY (job_id) REFERENCES jobs(id) ON DELETE RESTRICT;
+
+-- Uniqueness only applies to rows that have not been soft-deleted
+ALTER TABLE applications DROP CONSTRAINT applications_job_id_job_seeker_id_key;
+CREATE UNIQUE INDEX idx_applications_job_job_seeker_active
+    ON applications(job_id, job_seeker_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE student_profiles DROP CONSTRAINT student_profiles_job_seeker_id_key;
+CREATE UNIQUE INDEX idx_student_profiles_job_seeker_active
+    ON student_profiles(job_seeker_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE firm_profiles DROP CONSTRAINT firm_profiles_employer_id_key;
+CREATE UNIQUE INDEX idx_firm_profiles_employer_active
+    ON firm_profiles(employer_id) WHERE deleted_at IS NULL;
+
+-- Create indexes
+CREATE INDEX idx_jobs_deleted_at ON jobs(deleted_at);
+CREATE INDEX idx_applications_deleted_at ON applications(deleted_at);
+CREATE INDEX idx_student_profiles_deleted_at ON student_profiles(deleted_at);
+CREATE INDEX idx_firm_profiles_deleted_at ON firm_profiles(deleted_at);
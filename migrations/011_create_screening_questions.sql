@@ -0,0 +1,35 @@
+-- Create screening_questions table (questions employers attach to a job)
+CREATE TABLE screening_questions (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    prompt TEXT NOT NULL,
+    question_type VARCHAR(50) NOT NULL CHECK (question_type IN ('text', 'yes_no', 'multiple_choice', 'numeric')),
+    options JSON,
+    is_required BOOLEAN NOT NULL DEFAULT FALSE,
+    is_knockout BOOLEAN NOT NULL DEFAULT FALSE,
+    accepted_answers JSON,
+    min_value NUMERIC,
+    max_value NUMERIC,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Cover letter and knockout outcome on applications
+ALTER TABLE applications ADD COLUMN cover_letter TEXT;
+ALTER TABLE applications ADD COLUMN auto_rejected BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Create application_answers table (candidate answers to screening questions)
+CREATE TABLE application_answers (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    screening_question_id INTEGER NOT NULL REFERENCES screening_questions(id) ON DELETE CASCADE,
+    answer TEXT,
+    passed BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(application_id, screening_question_id)
+);
+
+-- Create indexes
+CREATE INDEX idx_screening_questions_job_id ON screening_questions(job_id);
+CREATE INDEX idx_application_answers_application_id ON application_answers(application_id);
@@ -0,0 +1,49 @@
+-- Create pipeline_stages table (per-job hiring pipeline)
+CREATE TABLE pipeline_stages (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    key VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    position INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(50) NOT NULL CHECK (status IN ('applied', 'shortlisted', 'rejected', 'selected')),
+    allowed_next JSON NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(job_id, key)
+);
+
+-- Track the pipeline stage of each application, starting from its current status
+ALTER TABLE applications ADD COLUMN stage VARCHAR(50);
+UPDATE applications SET stage = status;
+ALTER TABLE applications ALTER COLUMN stage SET NOT NULL;
+
+-- Create application_status_changes table (full stage history)
+CREATE TABLE application_status_changes (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    from_stage VARCHAR(50),
+    to_stage VARCHAR(50) NOT NULL,
+    from_status VARCHAR(50),
+    to_status VARCHAR(50) NOT NULL,
+    changed_by_user_id INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Give existing applications a starting history: when they were submitted,
+-- and the status they have since reached, so their timelines aren't empty
+INSERT INTO application_status_changes (application_id, to_stage, to_status, changed_by_user_id, created_at)
+SELECT applications.id, 'applied', 'applied', job_seekers.user_id, COALESCE(applications.applied_at, CURRENT_TIMESTAMP)
+FROM applications
+JOIN job_seekers ON job_seekers.id = applications.job_seeker_id;
+
+INSERT INTO application_status_changes (application_id, from_stage, to_stage, from_status, to_status, changed_by_user_id, created_at)
+SELECT applications.id, 'applied', applications.stage, 'applied', applications.status, employers.user_id, COALESCE(applications.updated_at, CURRENT_TIMESTAMP)
+FROM applications
+JOIN jobs ON jobs.id = applications.job_id
+JOIN employers ON employers.id = jobs.employer_id
+WHERE applications.status <> 'applied';
+
+-- Create indexes
+CREATE INDEX idx_pipeline_stages_job_id ON pipeline_stages(job_id);
+CREATE INDEX idx_applications_stage ON applications(stage);
+CREATE INDEX idx_application_status_changes_application_id ON application_status_changes(application_id);
@@ -0,0 +1,2 @@
+-- Optional note shown to the candidate on their application timeline
+ALTER TABLE application_status_changes ADD COLUMN candidate_note TEXT;
@@ -0,0 +1,37 @@
+-- Create application_notes table (private hiring team notes)
+CREATE TABLE application_notes (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    author_user_id INTEGER NOT NULL REFERENCES users(id),
+    body TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create application_ratings table (one rating per rater)
+CREATE TABLE application_ratings (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    rater_user_id INTEGER NOT NULL REFERENCES users(id),
+    rating INTEGER NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(application_id, rater_user_id)
+);
+
+-- Create application_tags table
+CREATE TABLE application_tags (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    tag VARCHAR(50) NOT NULL,
+    created_by_user_id INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(application_id, tag)
+);
+
+-- Create indexes
+CREATE INDEX idx_application_notes_application_id ON application_notes(application_id);
+CREATE INDEX idx_application_ratings_application_id ON application_ratings(application_id);
+CREATE INDEX idx_application_tags_tag ON application_tags(tag);
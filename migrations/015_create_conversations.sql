@@ -0,0 +1,26 @@
+-- Create conversations table (one thread per application)
+CREATE TABLE conversations (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER UNIQUE NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    last_message_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create messages table
+CREATE TABLE messages (
+    id SERIAL PRIMARY KEY,
+    conversation_id INTEGER NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+    sender_user_id INTEGER NOT NULL REFERENCES users(id),
+    body TEXT,
+    attachment_url VARCHAR(500),
+    attachment_name VARCHAR(255),
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CHECK (COALESCE(body, '') <> '' OR attachment_url IS NOT NULL)
+);
+
+-- Create indexes
+CREATE INDEX idx_messages_conversation_id ON messages(conversation_id);
+CREATE INDEX idx_messages_unread ON messages(conversation_id, sender_user_id) WHERE read_at IS NULL;
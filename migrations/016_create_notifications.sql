@@ -0,0 +1,20 @@
+-- Create notifications table
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL CHECK (type IN ('application_status_changed', 'new_message', 'new_applicant', 'job_closing_soon')),
+    title VARCHAR(255) NOT NULL,
+    body TEXT,
+    job_id INTEGER REFERENCES jobs(id) ON DELETE CASCADE,
+    application_id INTEGER REFERENCES applications(id) ON DELETE CASCADE,
+    conversation_id INTEGER REFERENCES conversations(id) ON DELETE CASCADE,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Remember which jobs already had their closing soon reminder
+ALTER TABLE jobs ADD COLUMN closing_notice_at TIMESTAMP;
+
+-- Create indexes
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, created_at DESC);
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
@@ -0,0 +1,29 @@
+-- Create email outbox table
+CREATE TABLE email_outbox (
+    id SERIAL PRIMARY KEY,
+    to_email VARCHAR(255) NOT NULL,
+    template VARCHAR(100) NOT NULL,
+    locale VARCHAR(10) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    html_body TEXT NOT NULL,
+    text_body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'sent', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Preferred language for emails
+ALTER TABLE users ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'en';
+
+-- Remember which jobs already had their expiry email
+ALTER TABLE jobs ADD COLUMN expiry_notice_at TIMESTAMP;
+
+-- Jobs that expired before this migration don't get a late email
+UPDATE jobs SET expiry_notice_at = NOW() WHERE application_deadline <= NOW();
+
+-- Create indexes
+CREATE INDEX idx_email_outbox_due ON email_outbox(next_attempt_at) WHERE status = 'pending';
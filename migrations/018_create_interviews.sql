@@ -0,0 +1,44 @@
+-- Create interviews table
+CREATE TABLE interviews (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'proposed' CHECK (status IN ('proposed', 'scheduled', 'cancelled')),
+    mode VARCHAR(20) NOT NULL CHECK (mode IN ('online', 'on_site')),
+    meeting_url VARCHAR(500),
+    location VARCHAR(500),
+    timezone VARCHAR(64) NOT NULL,
+    duration_minutes INTEGER NOT NULL CHECK (duration_minutes > 0),
+    notes TEXT,
+    selected_slot_id INTEGER,
+    scheduled_at TIMESTAMP,
+    sequence INTEGER NOT NULL DEFAULT 0,
+    cancel_reason TEXT,
+    cancelled_by_user_id INTEGER REFERENCES users(id),
+    reminder_sent_at TIMESTAMP,
+    created_by_user_id INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Create interview slots table
+CREATE TABLE interview_slots (
+    id SERIAL PRIMARY KEY,
+    interview_id INTEGER NOT NULL REFERENCES interviews(id) ON DELETE CASCADE,
+    starts_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Secret token for subscribing to a user's interview calendar feed
+ALTER TABLE users ADD COLUMN calendar_token VARCHAR(64) UNIQUE;
+
+-- Allow interview notifications
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check CHECK (type IN (
+    'application_status_changed', 'new_message', 'new_applicant', 'job_closing_soon',
+    'interview_proposed', 'interview_scheduled', 'interview_cancelled', 'interview_reminder'
+));
+
+-- Create indexes
+CREATE INDEX idx_interviews_application_id ON interviews(application_id);
+CREATE INDEX idx_interviews_upcoming ON interviews(scheduled_at) WHERE status = 'scheduled';
+CREATE INDEX idx_interview_slots_interview_id ON interview_slots(interview_id);
@@ -0,0 +1,34 @@
+-- Create offers table
+CREATE TABLE offers (
+    id SERIAL PRIMARY KEY,
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'withdrawn', 'expired')),
+    stipend VARCHAR(100) NOT NULL,
+    start_date DATE NOT NULL,
+    duration VARCHAR(50) NOT NULL,
+    message TEXT,
+    document_url VARCHAR(500),
+    document_name VARCHAR(255),
+    expires_at TIMESTAMP NOT NULL,
+    responded_at TIMESTAMP,
+    decline_reason TEXT,
+    created_by_user_id INTEGER NOT NULL REFERENCES users(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Number of people the job hires; it closes once that many offers are accepted
+ALTER TABLE jobs ADD COLUMN openings INTEGER NOT NULL DEFAULT 1 CHECK (openings > 0);
+
+-- Allow offer notifications
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check CHECK (type IN (
+    'application_status_changed', 'new_message', 'new_applicant', 'job_closing_soon',
+    'interview_proposed', 'interview_scheduled', 'interview_cancelled', 'interview_reminder',
+    'offer_received', 'offer_responded'
+));
+
+-- Only one open or accepted offer per application
+CREATE UNIQUE INDEX idx_offers_active_application ON offers(application_id) WHERE status IN ('pending', 'accepted');
+CREATE INDEX idx_offers_application_id ON offers(application_id);
+CREATE INDEX idx_offers_pending_expiry ON offers(expires_at) WHERE status = 'pending';
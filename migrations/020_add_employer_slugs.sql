@@ -0,0 +1,16 @@
+-- Public URL slug for employer pages, derived from the company name
+ALTER TABLE employers ADD COLUMN slug VARCHAR(120);
+
+-- Same rules as utils.Slugify, including its 100 character limit
+UPDATE employers SET slug = TRIM(BOTH '-' FROM LEFT(
+    TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(company_name), '[^a-z0-9]+', '-', 'g')), 100));
+UPDATE employers SET slug = 'company' WHERE slug = '';
+
+-- Companies sharing a name keep the oldest slug as-is; the rest get "--<id>"
+-- appended. Slugify never produces a double hyphen, so these can't collide
+-- with any other slug.
+UPDATE employers e SET slug = e.slug || '--' || e.id
+WHERE EXISTS (SELECT 1 FROM employers o WHERE o.slug = e.slug AND o.id < e.id);
+
+ALTER TABLE employers ALTER COLUMN slug SET NOT NULL;
+CREATE UNIQUE INDEX idx_employers_slug ON employers(slug);
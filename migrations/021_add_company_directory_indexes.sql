@@ -0,0 +1,5 @@
+-- Indexes for filtering the public company directory
+CREATE INDEX idx_employers_employer_type ON employers(employer_type);
+CREATE INDEX idx_employers_is_hiring ON employers(is_hiring);
+CREATE INDEX idx_firm_profiles_primary_discipline ON firm_profiles(LOWER(primary_discipline));
+CREATE INDEX idx_jobs_employer_open ON jobs(employer_id) WHERE is_active = true AND is_draft = false AND deleted_at IS NULL;
@@ -0,0 +1,9 @@
+-- Job seekers opt in to being found by employers in talent search
+ALTER TABLE job_seekers ADD COLUMN open_to_opportunities BOOLEAN NOT NULL DEFAULT false;
+
+-- full: name, resume and portfolio are shown; anonymous: they stay hidden
+-- until the candidate accepts an invitation
+ALTER TABLE job_seekers ADD COLUMN talent_visibility VARCHAR(20) NOT NULL DEFAULT 'full'
+    CHECK (talent_visibility IN ('full', 'anonymous'));
+
+CREATE INDEX idx_job_seekers_open_to_opportunities ON job_seekers(job_seeker_type) WHERE open_to_opportunities = true;
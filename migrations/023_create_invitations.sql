@@ -0,0 +1,34 @@
+-- Employers invite candidates found in talent search to apply to a job
+CREATE TABLE invitations (
+    id SERIAL PRIMARY KEY,
+    job_id INTEGER NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    job_seeker_id INTEGER NOT NULL REFERENCES job_seekers(id) ON DELETE CASCADE,
+    employer_id INTEGER NOT NULL REFERENCES employers(id) ON DELETE CASCADE,
+    invited_by_user_id INTEGER NOT NULL REFERENCES users(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'expired')),
+    message TEXT,
+    expires_at TIMESTAMP NOT NULL,
+    responded_at TIMESTAMP,
+    decline_reason TEXT,
+    application_id INTEGER REFERENCES applications(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- How the candidate came to apply
+ALTER TABLE applications ADD COLUMN source VARCHAR(20) NOT NULL DEFAULT 'direct' CHECK (source IN ('direct', 'invited'));
+
+-- Allow invitation notifications
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check CHECK (type IN (
+    'application_status_changed', 'new_message', 'new_applicant', 'job_closing_soon',
+    'interview_proposed', 'interview_scheduled', 'interview_cancelled', 'interview_reminder',
+    'offer_received', 'offer_responded',
+    'invitation_received', 'invitation_declined'
+));
+
+-- Only one open invitation per candidate and job
+CREATE UNIQUE INDEX idx_invitations_pending_job_seeker ON invitations(job_id, job_seeker_id) WHERE status = 'pending';
+CREATE INDEX idx_invitations_job_seeker_id ON invitations(job_seeker_id);
+-- Rate limiting counts an employer's recent invitations
+CREATE INDEX idx_invitations_employer_created_at ON invitations(employer_id, created_at);
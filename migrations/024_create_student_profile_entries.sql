@@ -0,0 +1,37 @@
+-- Structured internships and freelance projects on student profiles,
+-- replacing the previous_internships and freelance_projects string arrays
+CREATE TABLE student_profile_entries (
+    id SERIAL PRIMARY KEY,
+    student_profile_id INTEGER NOT NULL REFERENCES student_profiles(id) ON DELETE CASCADE,
+    entry_type VARCHAR(30) NOT NULL CHECK (entry_type IN ('internship', 'freelance_project')),
+    organization VARCHAR(255) NOT NULL,
+    role VARCHAR(255) NOT NULL DEFAULT '',
+    start_date DATE,
+    end_date DATE,
+    description TEXT,
+    links JSON,
+    media JSON,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_date IS NULL OR start_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX idx_student_profile_entries_profile ON student_profile_entries(student_profile_id, entry_type);
+
+-- Carry over existing entries. They were free text, so the text becomes the
+-- organization (kept in full in the description when too long) and the
+-- candidate can fill in the rest.
+INSERT INTO student_profile_entries (student_profile_id, entry_type, organization, description)
+SELECT student_profiles.id, 'internship', LEFT(TRIM(item.value), 255),
+       CASE WHEN LENGTH(TRIM(item.value)) > 255 THEN TRIM(item.value) END
+FROM student_profiles, json_array_elements_text(COALESCE(student_profiles.previous_internships, '[]'::json)) AS item(value)
+WHERE TRIM(item.value) <> '';
+
+INSERT INTO student_profile_entries (student_profile_id, entry_type, organization, description)
+SELECT student_profiles.id, 'freelance_project', LEFT(TRIM(item.value), 255),
+       CASE WHEN LENGTH(TRIM(item.value)) > 255 THEN TRIM(item.value) END
+FROM student_profiles, json_array_elements_text(COALESCE(student_profiles.freelance_projects, '[]'::json)) AS item(value)
+WHERE TRIM(item.value) <> '';
+
+ALTER TABLE student_profiles DROP COLUMN previous_internships;
+ALTER TABLE student_profiles DROP COLUMN freelance_projects;
@@ -0,0 +1,49 @@
+-- Profile extensions for job seekers of type 'professional' and 'freelancer'
+CREATE TABLE professional_profiles (
+    id SERIAL PRIMARY KEY,
+    job_seeker_id INTEGER NOT NULL REFERENCES job_seekers(id) ON DELETE CASCADE,
+    current_title VARCHAR(255),
+    years_of_experience INTEGER NOT NULL DEFAULT 0 CHECK (years_of_experience >= 0),
+    coa_registration_number VARCHAR(50),
+    other_licenses JSON,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_professional_profiles_job_seeker_active
+    ON professional_profiles(job_seeker_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_professional_profiles_deleted_at ON professional_profiles(deleted_at);
+
+CREATE TABLE work_history_entries (
+    id SERIAL PRIMARY KEY,
+    professional_profile_id INTEGER NOT NULL REFERENCES professional_profiles(id) ON DELETE CASCADE,
+    organization VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE,
+    description TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX idx_work_history_entries_profile ON work_history_entries(professional_profile_id);
+
+CREATE TABLE freelancer_profiles (
+    id SERIAL PRIMARY KEY,
+    job_seeker_id INTEGER NOT NULL REFERENCES job_seekers(id) ON DELETE CASCADE,
+    hourly_rate INTEGER CHECK (hourly_rate >= 0),
+    project_rate VARCHAR(100),
+    availability VARCHAR(20) NOT NULL DEFAULT 'available' CHECK (availability IN ('available', 'limited', 'unavailable')),
+    hours_per_week INTEGER CHECK (hours_per_week BETWEEN 0 AND 80),
+    services JSON,
+    clients JSON,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_freelancer_profiles_job_seeker_active
+    ON freelancer_profiles(job_seeker_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_freelancer_profiles_availability ON freelancer_profiles(availability);
+CREATE INDEX idx_freelancer_profiles_deleted_at ON freelancer_profiles(deleted_at);
@@ -0,0 +1,39 @@
+-- Structured portfolio: projects with ordered images and PDF pages
+CREATE TABLE portfolio_projects (
+    id SERIAL PRIMARY KEY,
+    job_seeker_id INTEGER NOT NULL REFERENCES job_seekers(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    year INTEGER,
+    typology VARCHAR(100),
+    role VARCHAR(255),
+    description TEXT,
+    software_used JSON,
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_portfolio_projects_job_seeker ON portfolio_projects(job_seeker_id, position);
+
+CREATE TABLE portfolio_media (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES portfolio_projects(id) ON DELETE CASCADE,
+    url VARCHAR(500) NOT NULL,
+    media_type VARCHAR(10) NOT NULL CHECK (media_type IN ('image', 'pdf')),
+    caption VARCHAR(255),
+    position INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_portfolio_media_project ON portfolio_media(project_id, position);
+
+-- Secret for the public portfolio link; NULL when sharing is off
+ALTER TABLE job_seekers ADD COLUMN portfolio_share_token VARCHAR(64);
+CREATE UNIQUE INDEX idx_job_seekers_portfolio_share_token ON job_seekers(portfolio_share_token);
+
+-- Projects a candidate chose to show with an application
+CREATE TABLE application_portfolio_projects (
+    application_id INTEGER NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    portfolio_project_id INTEGER NOT NULL REFERENCES portfolio_projects(id) ON DELETE CASCADE,
+    PRIMARY KEY (application_id, portfolio_project_id)
+);
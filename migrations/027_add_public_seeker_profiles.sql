@@ -0,0 +1,11 @@
+-- Public shareable job seeker profiles at /api/profiles/:slug
+ALTER TABLE job_seekers ADD COLUMN profile_slug VARCHAR(120);
+ALTER TABLE job_seekers ADD COLUMN profile_visibility VARCHAR(20) NOT NULL DEFAULT 'private'
+    CHECK (profile_visibility IN ('private', 'unlisted', 'public'));
+
+-- Per-field privacy; the phone number is hidden unless the seeker opts in
+ALTER TABLE job_seekers ADD COLUMN hide_phone BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE job_seekers ADD COLUMN hide_city BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE job_seekers ADD COLUMN hide_resume BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE UNIQUE INDEX idx_job_seekers_profile_slug ON job_seekers(profile_slug);
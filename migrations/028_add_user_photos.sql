@@ -0,0 +1,4 @@
+-- Uploaded profile photos, cropped to 256px and a 64px thumbnail. Users
+-- without one get a generated initials avatar at /api/users/:id/avatar.
+ALTER TABLE users ADD COLUMN photo_url VARCHAR(500) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN photo_thumb_url VARCHAR(500) NOT NULL DEFAULT '';
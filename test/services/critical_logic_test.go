@@ -1,9 +1,14 @@
 package services
 
 import (
+	"image"
+	"image/color"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/dekkaladiwakar/black-pages-backend/internal/models"
+	appservices "github.com/dekkaladiwakar/black-pages-backend/internal/services"
 	"github.com/dekkaladiwakar/black-pages-backend/internal/utils"
 
 	"github.com/stretchr/testify/assert"
@@ -297,4 +302,568 @@ func TestValidationHelpers(t *testing.T) {
 		assert.Equal(t, 0, min(0, 5))
 		assert.Equal(t, -1, min(-1, 5))
 	})
-}
\ No newline at end of file
+}
+func TestEvaluateScreeningAnswers(t *testing.T) {
+	minYears := 2.0
+	questions := []models.ScreeningQuestion{
+		{ID: 1, Prompt: "Are you licensed?", QuestionType: "yes_no", IsKnockout: true, AcceptedAnswers: `["yes"]`},
+		{ID: 2, Prompt: "Years of experience", QuestionType: "numeric", IsKnockout: true, MinValue: &minYears},
+		{ID: 3, Prompt: "Preferred tool", QuestionType: "multiple_choice", Options: `["Revit","AutoCAD"]`},
+		{ID: 4, Prompt: "Anything else?", QuestionType: "text"},
+	}
+
+	tests := []struct {
+		name          string
+		answers       []appservices.ScreeningAnswerInput
+		expectError   bool
+		expectKnocked bool
+	}{
+		{
+			name: "Passing answers",
+			answers: []appservices.ScreeningAnswerInput{
+				{QuestionID: 1, Answer: "Yes"},
+				{QuestionID: 2, Answer: "3"},
+				{QuestionID: 3, Answer: "revit"},
+			},
+		},
+		{
+			name: "Failed knockout answer",
+			answers: []appservices.ScreeningAnswerInput{
+				{QuestionID: 1, Answer: "no"},
+				{QuestionID: 2, Answer: "5"},
+			},
+			expectKnocked: true,
+		},
+		{
+			name: "Numeric below minimum",
+			answers: []appservices.ScreeningAnswerInput{
+				{QuestionID: 1, Answer: "yes"},
+				{QuestionID: 2, Answer: "1"},
+			},
+			expectKnocked: true,
+		},
+		{
+			name: "Missing knockout answer",
+			answers: []appservices.ScreeningAnswerInput{
+				{QuestionID: 2, Answer: "3"},
+			},
+			expectError: true,
+		},
+		{
+			name: "Option not in list",
+			answers: []appservices.ScreeningAnswerInput{
+				{QuestionID: 1, Answer: "yes"},
+				{QuestionID: 2, Answer: "3"},
+				{QuestionID: 3, Answer: "SketchUp"},
+			},
+			expectError: true,
+		},
+		{
+			name: "Unknown question",
+			answers: []appservices.ScreeningAnswerInput{
+				{QuestionID: 1, Answer: "yes"},
+				{QuestionID: 2, Answer: "3"},
+				{QuestionID: 99, Answer: "hello"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, knockedOut, err := appservices.EvaluateScreeningAnswers(questions, tt.answers)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectKnocked, knockedOut)
+		})
+	}
+}
+
+func TestResolveStageTransition(t *testing.T) {
+	stages := []models.PipelineStage{
+		{Key: "applied", Name: "Applied", Status: "applied", AllowedNext: `["portfolio_review","rejected"]`},
+		{Key: "portfolio_review", Name: "Portfolio review", Status: "shortlisted", AllowedNext: `["interview","rejected"]`},
+		{Key: "interview", Name: "Interview", Status: "shortlisted", AllowedNext: `["offer","rejected"]`},
+		{Key: "offer", Name: "Offer", Status: "selected", AllowedNext: `[]`},
+		{Key: "rejected", Name: "Rejected", Status: "rejected", AllowedNext: `[]`},
+	}
+
+	tests := []struct {
+		name         string
+		current      string
+		targetStage  string
+		targetStatus string
+		expected     string
+		expectError  bool
+	}{
+		{name: "Allowed stage", current: "applied", targetStage: "portfolio_review", expected: "portfolio_review"},
+		{name: "Skipping a stage", current: "applied", targetStage: "interview", expectError: true},
+		{name: "Status resolves to next stage", current: "portfolio_review", targetStatus: "shortlisted", expected: "interview"},
+		{name: "Status with no allowed stage", current: "applied", targetStatus: "selected", expectError: true},
+		{name: "Stage and status mismatch", current: "interview", targetStage: "offer", targetStatus: "rejected", expectError: true},
+		{name: "Leaving a final stage", current: "offer", targetStage: "rejected", expectError: true},
+		{name: "Unknown stage", current: "applied", targetStage: "design_test", expectError: true},
+		{name: "Nothing requested", current: "applied", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := appservices.ResolveStageTransition(stages, tt.current, tt.targetStage, tt.targetStatus)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, next.Key)
+		})
+	}
+}
+
+func TestRenderEmail(t *testing.T) {
+	data := appservices.ApplicationEmailData{
+		CandidateName:  "Asha",
+		JobTitle:       "Junior Architect <Urban>",
+		CompanyName:    "Studio North",
+		Stage:          "Interview",
+		Note:           "We'd love to meet you.",
+		ApplicationURL: "http://localhost:3000/applications/7",
+	}
+
+	tests := []struct {
+		name           string
+		locale         string
+		template       string
+		expectedLocale string
+		expectError    bool
+	}{
+		{name: "Default locale", locale: "en", template: appservices.EmailApplicationStatusChanged, expectedLocale: "en"},
+		{name: "Regional locale falls back to language", locale: "en-GB", template: appservices.EmailApplicationStatusChanged, expectedLocale: "en"},
+		{name: "Missing translation falls back to English", locale: "hi", template: appservices.EmailApplicationStatusChanged, expectedLocale: "en"},
+		{name: "Empty locale", locale: "", template: appservices.EmailApplicationStatusChanged, expectedLocale: "en"},
+		{name: "Unknown template", locale: "en", template: "does_not_exist", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			email, err := appservices.RenderEmail(tt.locale, tt.template, data)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedLocale, email.Locale)
+			assert.Equal(t, "Update on your application for Junior Architect <Urban>", email.Subject)
+			assert.Contains(t, email.Text, "moved to Interview")
+			assert.Contains(t, email.Text, "We'd love to meet you.")
+			// Values are escaped in the HTML body but not in the text one
+			assert.Contains(t, email.HTML, "Junior Architect &lt;Urban&gt;")
+		})
+	}
+}
+
+func TestEmailRetryDelay(t *testing.T) {
+	assert.Equal(t, time.Minute, appservices.EmailRetryDelay(1))
+	assert.Equal(t, 2*time.Minute, appservices.EmailRetryDelay(2))
+	assert.Equal(t, 16*time.Minute, appservices.EmailRetryDelay(5))
+	assert.Equal(t, time.Hour, appservices.EmailRetryDelay(10))
+	assert.Equal(t, time.Hour, appservices.EmailRetryDelay(100))
+}
+
+func TestBuildICS(t *testing.T) {
+	kolkata := time.FixedZone("IST", 5*60*60+30*60)
+	start := time.Date(2026, 3, 10, 15, 30, 0, 0, kolkata)
+
+	event := utils.CalendarEvent{
+		UID:         "interview-7@blackpages",
+		Sequence:    2,
+		Start:       start,
+		End:         start.Add(45 * time.Minute),
+		Summary:     "Interview: Junior Architect, Studio; North",
+		Description: "Bring your portfolio.\nAsk for reception.",
+		Location:    "12 MG Road, Bengaluru",
+		UpdatedAt:   start.Add(-24 * time.Hour),
+	}
+
+	ics := utils.BuildICS("Black Pages interviews", []utils.CalendarEvent{event})
+
+	assert.Contains(t, ics, "BEGIN:VCALENDAR\r\n")
+	assert.Contains(t, ics, "X-WR-CALNAME:Black Pages interviews\r\n")
+	// Times are converted to UTC
+	assert.Contains(t, ics, "DTSTART:20260310T100000Z\r\n")
+	assert.Contains(t, ics, "DTEND:20260310T104500Z\r\n")
+	assert.Contains(t, ics, "SEQUENCE:2\r\n")
+	// Commas, semicolons and newlines are escaped in text values
+	assert.Contains(t, ics, `SUMMARY:Interview: Junior Architect\, Studio\; North`)
+	assert.Contains(t, ics, `DESCRIPTION:Bring your portfolio.\nAsk for reception.`)
+	assert.Contains(t, ics, "STATUS:CONFIRMED\r\n")
+
+	event.Cancelled = true
+	event.Description = strings.Repeat("Long interview notes. ", 10)
+	ics = utils.BuildICS("", []utils.CalendarEvent{event})
+
+	assert.Contains(t, ics, "STATUS:CANCELLED\r\n")
+	assert.NotContains(t, ics, "X-WR-CALNAME")
+	// Long lines are folded to at most 75 octets
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+	}
+	unfolded := strings.ReplaceAll(ics, "\r\n ", "")
+	assert.Contains(t, unfolded, "DESCRIPTION:"+strings.TrimSpace(strings.Repeat("Long interview notes. ", 10)))
+}
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Simple name",
+			input:    "Studio North",
+			expected: "studio-north",
+		},
+		{
+			name:     "Punctuation collapses to single hyphens",
+			input:    "  Mehta & Sons (Architects) Pvt. Ltd.  ",
+			expected: "mehta-sons-architects-pvt-ltd",
+		},
+		{
+			name:     "Digits are kept",
+			input:    "Studio 4D",
+			expected: "studio-4d",
+		},
+		{
+			name:     "Non-ASCII letters are dropped",
+			input:    "Café Désign",
+			expected: "caf-d-sign",
+		},
+		{
+			name:     "Nothing usable",
+			input:    "!!!",
+			expected: "",
+		},
+		{
+			name:     "Long names are truncated",
+			input:    strings.Repeat("ab ", 60),
+			expected: strings.Repeat("ab-", 33) + "a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utils.Slugify(tt.input))
+		})
+	}
+}
+
+func TestComputeProfileCompleteness(t *testing.T) {
+	complete := models.JobSeeker{
+		FullName:      "Asha Rao",
+		JobSeekerType: "student",
+		CurrentCity:   "Pune",
+		Phone:         "9876543210",
+		DesiredField:  "Architecture",
+		ResumeURL:     "https://cdn.example.com/resume.pdf",
+		PortfolioURL:  "https://asha.example.com",
+		Skills:        `["Rhino","Revit","Model making"]`,
+		StudentProfile: &models.StudentProfile{
+			SoftwareProficiency: `["AutoCAD"]`,
+			Internships:         []models.StudentProfileEntry{{Organization: "Studio North"}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		modify        func(js *models.JobSeeker)
+		expectedScore int
+		missing       []string
+	}{
+		{
+			name:          "Complete student profile",
+			modify:        func(js *models.JobSeeker) {},
+			expectedScore: 100,
+			missing:       []string{},
+		},
+		{
+			name: "Missing resume and too few skills",
+			modify: func(js *models.JobSeeker) {
+				js.ResumeURL = ""
+				js.Skills = `["Rhino"]`
+			},
+			expectedScore: 65,
+			missing:       []string{"resume", "skills"},
+		},
+		{
+			name: "Student without an extension",
+			modify: func(js *models.JobSeeker) {
+				js.StudentProfile = nil
+				js.PortfolioURL = ""
+			},
+			expectedScore: 45,
+			missing:       []string{"student_profile", "portfolio"},
+		},
+		{
+			name: "Freelancer with services but no rates",
+			modify: func(js *models.JobSeeker) {
+				js.JobSeekerType = "freelancer"
+				js.FreelancerProfile = &models.FreelancerProfile{Services: `["3D visualisation"]`}
+			},
+			expectedScore: 90,
+			missing:       []string{"rates"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobSeeker := complete
+			tt.modify(&jobSeeker)
+
+			result := appservices.ComputeProfileCompleteness(&jobSeeker)
+			assert.Equal(t, tt.expectedScore, result.Score)
+
+			fields := []string{}
+			for _, item := range result.Missing {
+				fields = append(fields, item.Field)
+			}
+			assert.Equal(t, tt.missing, fields)
+		})
+	}
+}
+
+func TestNewPublicProfile(t *testing.T) {
+	slug := "asha-rao"
+	base := models.JobSeeker{
+		FullName:          "Asha Rao",
+		CurrentCity:       "Pune",
+		Phone:             "9876543210",
+		ResumeURL:         "https://files.example.com/resume.pdf",
+		Skills:            `["Revit"]`,
+		ProfileSlug:       &slug,
+		ProfileVisibility: appservices.ProfileVisibilityPublic,
+		PortfolioProjects: []models.PortfolioProject{{Title: "Riverside Library"}},
+	}
+	shareToken := "secret"
+
+	tests := []struct {
+		name      string
+		modify    func(js *models.JobSeeker)
+		city      string
+		phone     string
+		resume    string
+		indexable bool
+		projects  int
+	}{
+		{
+			name:      "Nothing hidden, portfolio shared",
+			modify:    func(js *models.JobSeeker) { js.PortfolioShareToken = &shareToken },
+			city:      "Pune",
+			phone:     "9876543210",
+			resume:    "https://files.example.com/resume.pdf",
+			indexable: true,
+			projects:  1,
+		},
+		{
+			name: "Phone and resume hidden, portfolio not shared",
+			modify: func(js *models.JobSeeker) {
+				js.HidePhone = true
+				js.HideResume = true
+			},
+			city:      "Pune",
+			indexable: true,
+		},
+		{
+			name: "Unlisted with city hidden",
+			modify: func(js *models.JobSeeker) {
+				js.ProfileVisibility = appservices.ProfileVisibilityUnlisted
+				js.HideCity = true
+			},
+			phone:  "9876543210",
+			resume: "https://files.example.com/resume.pdf",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jobSeeker := base
+			tt.modify(&jobSeeker)
+
+			profile := appservices.NewPublicProfile(&jobSeeker)
+			assert.Equal(t, "asha-rao", profile.Slug)
+			assert.Equal(t, tt.city, profile.CurrentCity)
+			assert.Equal(t, tt.phone, profile.Phone)
+			assert.Equal(t, tt.resume, profile.ResumeURL)
+			assert.Equal(t, tt.indexable, profile.Indexable)
+			assert.Len(t, profile.PortfolioProjects, tt.projects)
+			assert.Equal(t, []string{"Revit"}, profile.Skills)
+		})
+	}
+}
+
+func TestInitials(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "First and last name", input: "asha rao", expected: "AR"},
+		{name: "Middle names are skipped", input: "Asha K. Devi Rao", expected: "AR"},
+		{name: "Single word", input: "Studio", expected: "S"},
+		{name: "Non-ASCII letters", input: "Élise Öztürk", expected: "ÉÖ"},
+		{name: "No letters", input: " - ", expected: "?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, utils.Initials(tt.input))
+		})
+	}
+}
+
+func TestInitialsAvatarSVG(t *testing.T) {
+	svg := utils.InitialsAvatarSVG("Asha Rao", 64)
+	assert.Contains(t, svg, `width="64" height="64"`)
+	assert.Contains(t, svg, ">AR</text>")
+	// The same name always gets the same colour
+	assert.Equal(t, svg, utils.InitialsAvatarSVG("asha rao ", 64))
+}
+
+func TestCropSquare(t *testing.T) {
+	// A 300x100 image: red on the left and right thirds, blue in the middle
+	img := image.NewRGBA(image.Rect(0, 0, 300, 100))
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 300; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 100 && x < 200 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	cropped := utils.CropSquare(img, 64)
+	assert.Equal(t, image.Rect(0, 0, 64, 64), cropped.Bounds())
+	// Only the centred square is kept
+	assert.Equal(t, color.RGBA{B: 255, A: 255}, cropped.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{B: 255, A: 255}, cropped.RGBAAt(63, 63))
+
+	// Transparent pixels become white
+	transparent := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+	assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, utils.CropSquare(transparent, 20).RGBAAt(5, 5))
+}
+
+func TestEligibilityIssues(t *testing.T) {
+	openJob := models.Job{
+		IsActive:            true,
+		ApplicationDeadline: time.Now().Add(24 * time.Hour),
+		ResumeRequired:      true,
+		PortfolioRequired:   true,
+	}
+	jobSeeker := models.JobSeeker{ResumeURL: "https://files.example.com/resume.pdf"}
+
+	tests := []struct {
+		name              string
+		modifyJob         func(job *models.Job)
+		modifySeeker      func(js *models.JobSeeker)
+		alreadyApplied    bool
+		portfolioProjects int
+		expected          []string
+	}{
+		{
+			name:              "Portfolio projects meet the portfolio requirement",
+			portfolioProjects: 2,
+			expected:          []string{},
+		},
+		{
+			name:         "Portfolio PDF meets the portfolio requirement",
+			modifySeeker: func(js *models.JobSeeker) { js.PortfolioURL = "https://files.example.com/portfolio.pdf" },
+			expected:     []string{},
+		},
+		{
+			name:     "No portfolio at all",
+			expected: []string{"portfolio_required"},
+		},
+		{
+			name: "Closed job past its deadline without a resume",
+			modifyJob: func(job *models.Job) {
+				job.IsActive = false
+				job.ApplicationDeadline = time.Now().Add(-time.Hour)
+			},
+			modifySeeker:      func(js *models.JobSeeker) { js.ResumeURL = "" },
+			alreadyApplied:    true,
+			portfolioProjects: 1,
+			expected:          []string{"job_inactive", "deadline_passed", "already_applied", "resume_required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := openJob
+			if tt.modifyJob != nil {
+				tt.modifyJob(&job)
+			}
+			seeker := jobSeeker
+			if tt.modifySeeker != nil {
+				tt.modifySeeker(&seeker)
+			}
+
+			codes := []string{}
+			for _, issue := range appservices.EligibilityIssues(&job, &seeker, tt.alreadyApplied, tt.portfolioProjects) {
+				codes = append(codes, issue.Code)
+			}
+			assert.Equal(t, tt.expected, codes)
+		})
+	}
+}
+
+func TestRenderCandidateMessage(t *testing.T) {
+	data := appservices.CandidateMessageData{
+		CandidateName: "Asha Rao",
+		JobTitle:      "Junior Architect",
+		CompanyName:   "Studio {{Lotus}}",
+		Stage:         "Interview",
+		Status:        "shortlisted",
+	}
+
+	tests := []struct {
+		name        string
+		text        string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "Placeholders are substituted",
+			text:     "Hi {{.CandidateName}}, you're at {{ .Stage }} for {{.JobTitle}} at {{.CompanyName}}.",
+			expected: "Hi Asha Rao, you're at Interview for Junior Architect at Studio {{Lotus}}.",
+		},
+		{
+			name:        "Unknown placeholder",
+			text:        "Hi {{.Email}}",
+			expectError: true,
+		},
+		{
+			name:        "Template actions are rejected",
+			text:        `{{printf "%099999999d" 1}}`,
+			expectError: true,
+		},
+		{
+			name:        "Rendered message too long",
+			text:        strings.Repeat("{{.JobTitle}}", 150),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := appservices.RenderCandidateMessage(tt.text, data)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}